@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// conntrackFilter matches conntrack entries with src/dst/proto. It matches
+// all entries if nothing is given, unlike netlink.ConntrackFilter.
+type conntrackFilter struct {
+	netlink.ConntrackFilter
+	all bool
+}
+
+// MatchConntrackFlow implements netlink.CustomConntrackFilter
+func (f *conntrackFilter) MatchConntrackFlow(flow *netlink.ConntrackFlow) bool {
+	return f.all || f.ConntrackFilter.MatchConntrackFlow(flow)
+}
+
+// inetFamily returns conntrack address family of given ip
+func inetFamily(ip net.IP) netlink.InetFamily {
+	if ip.To4() != nil {
+		return unix.AF_INET
+	}
+	return unix.AF_INET6
+}
+
+// GetConntrackFilter converts from CLI argument to the filter of conntrack
+// entries and the address families to look up
+func GetConntrackFilter(command *parser.Command) (filter *conntrackFilter, families []netlink.InetFamily, err error) {
+	filter = &conntrackFilter{all: true}
+	families = []netlink.InetFamily{unix.AF_INET, unix.AF_INET6}
+
+	for _, option := range []struct {
+		val string
+		tp  netlink.ConntrackFilterType
+	}{
+		{command.OptionSrc, netlink.ConntrackOrigSrcIP},
+		{command.OptionDst, netlink.ConntrackOrigDstIP},
+	} {
+		if option.val == "" {
+			continue
+		}
+		ipnet, err := parseRulePrefix(option.val)
+		if err != nil || ipnet == nil {
+			return nil, nil, fmt.Errorf("invalid prefix %q", option.val)
+		}
+		family := inetFamily(ipnet.IP)
+		if len(families) == 1 && families[0] != family {
+			return nil, nil, fmt.Errorf("address family mismatch between src and dst")
+		}
+		families = []netlink.InetFamily{family}
+		filter.AddIPNet(option.tp, ipnet)
+		filter.all = false
+	}
+	if command.OptionProto != "" {
+		proto, ok := l4Protocols[command.OptionProto]
+		if !ok {
+			val, err := strconv.ParseUint(command.OptionProto, 10, 8)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid proto %q", command.OptionProto)
+			}
+			proto = byte(val)
+		}
+		filter.AddProtocol(proto)
+		filter.all = false
+	}
+	return filter, families, nil
+}
+
+// ShowFlushConntrack shows or flushes conntrack entries in the namespace
+func ShowFlushConntrack(command *parser.Command) (err error) {
+	filter, families, err := GetConntrackFilter(command)
+	if err != nil {
+		return err
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		count := uint(0)
+		for _, family := range families {
+			if command.Operation == parser.CONNTRACKFLUSH {
+				n, err1 := netlink.ConntrackDeleteFilters(netlink.ConntrackTable, family, filter)
+				if err1 != nil {
+					return fmt.Errorf("failed to flush conntrack (%d entries flushed): %v", count, err1)
+				}
+				count += n
+				continue
+			}
+			flows, err1 := netlink.ConntrackTableList(netlink.ConntrackTable, family)
+			if err1 != nil {
+				return err1
+			}
+			for _, flow := range flows {
+				if filter.MatchConntrackFlow(flow) {
+					fmt.Println(flow)
+				}
+			}
+		}
+		if command.Operation == parser.CONNTRACKFLUSH {
+			fmt.Printf("%d entries flushed\n", count)
+		}
+		return nil
+	})
+}
+
+// flushRouteConntrack deletes conntrack entries whose destination is in the
+// route, so that they take the new path. It needs to be called in the target
+// namespace.
+func flushRouteConntrack(route *netlink.Route) error {
+	dst := route.Dst
+	if dst == nil {
+		dst = &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)}
+		if route.Gw != nil && route.Gw.To4() == nil {
+			dst = &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}
+		}
+	}
+	filter := &netlink.ConntrackFilter{}
+	filter.AddIPNet(netlink.ConntrackOrigDstIP, dst)
+	n, err := netlink.ConntrackDeleteFilters(netlink.ConntrackTable, inetFamily(dst.IP), filter)
+	if err != nil {
+		return fmt.Errorf("failed to flush conntrack of %s: %v", dst, err)
+	}
+	fmt.Printf("%d conntrack entries flushed\n", n)
+	return nil
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// maxMplsLabel is the largest MPLS label, 20 bits
+const maxMplsLabel = 1<<20 - 1
+
+// getMplsLabel converts MPLS label given in CLI into number
+func getMplsLabel(label string) (int, error) {
+	val, err := strconv.Atoi(label)
+	if err != nil || val < 0 || val > maxMplsLabel {
+		return 0, fmt.Errorf("invalid mpls label %q", label)
+	}
+	return val, nil
+}
+
+// getMplsLabels converts MPLS label stack given as L1/L2/... into numbers
+func getMplsLabels(labels string) ([]int, error) {
+	var stack []int
+	for _, label := range strings.Split(labels, "/") {
+		val, err := getMplsLabel(label)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, val)
+	}
+	return stack, nil
+}
+
+// getRouteEncap converts 'encap' of route given in CLI into netlink.Encap
+func getRouteEncap(command *parser.Command) (netlink.Encap, error) {
+	switch command.OptionEncap {
+	case "":
+		return nil, nil
+	case "mpls":
+		labels, err := getMplsLabels(command.OptionLabels)
+		if err != nil {
+			return nil, err
+		}
+		return &netlink.MPLSEncap{Labels: labels}, nil
+	case "seg6":
+		return getSeg6Encap(command)
+	case "seg6local":
+		return getSeg6LocalEncap(command)
+	}
+	return nil, fmt.Errorf("unsupported encap %q", command.OptionEncap)
+}
+
+// seg6Modes are modes of seg6 encap
+var seg6Modes = map[string]int{
+	"encap":  nl.SEG6_IPTUN_MODE_ENCAP,
+	"inline": nl.SEG6_IPTUN_MODE_INLINE,
+}
+
+// getSeg6Encap converts 'encap seg6' given in CLI into netlink.SEG6Encap
+func getSeg6Encap(command *parser.Command) (*netlink.SEG6Encap, error) {
+	mode, ok := seg6Modes[command.OptionSeg6Mode]
+	if !ok {
+		return nil, fmt.Errorf("invalid seg6 mode %q", command.OptionSeg6Mode)
+	}
+	encap := &netlink.SEG6Encap{Mode: mode}
+	for _, seg := range strings.Split(command.OptionSegs, ",") {
+		ip := net.ParseIP(seg)
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("invalid segment %q", seg)
+		}
+		encap.Segments = append(encap.Segments, ip)
+	}
+	return encap, nil
+}
+
+// seg6localActions are seg6local actions which koro supports with the
+// parameters they take. One of the parameters is required if any.
+var seg6localActions = map[string]struct {
+	action int
+	params []string
+}{
+	"End":     {nl.SEG6_LOCAL_ACTION_END, nil},
+	"End.X":   {nl.SEG6_LOCAL_ACTION_END_X, []string{"nh6"}},
+	"End.T":   {nl.SEG6_LOCAL_ACTION_END_T, []string{"table"}},
+	"End.DX6": {nl.SEG6_LOCAL_ACTION_END_DX6, []string{"nh6"}},
+	"End.DT4": {nl.SEG6_LOCAL_ACTION_END_DT4, []string{"vrftable"}},
+	"End.DT6": {nl.SEG6_LOCAL_ACTION_END_DT6, []string{"table", "vrftable"}},
+}
+
+// getSeg6LocalEncap converts 'encap seg6local' given in CLI into
+// netlink.SEG6LocalEncap
+func getSeg6LocalEncap(command *parser.Command) (*netlink.SEG6LocalEncap, error) {
+	spec, ok := seg6localActions[command.OptionAction]
+	if !ok {
+		return nil, fmt.Errorf("unsupported seg6local action %q", command.OptionAction)
+	}
+	given := map[string]string{
+		"nh6":      command.OptionNh6,
+		"table":    command.OptionLocalTable,
+		"vrftable": command.OptionVrfTable,
+	}
+	count := 0
+	for _, name := range []string{"nh6", "table", "vrftable"} {
+		if given[name] == "" {
+			continue
+		}
+		found := false
+		for _, param := range spec.params {
+			found = found || param == name
+		}
+		if !found {
+			return nil, fmt.Errorf("%s does not support %s", command.OptionAction, name)
+		}
+		count++
+	}
+	if len(spec.params) > 0 && count != 1 {
+		return nil, fmt.Errorf("%s requires %s", command.OptionAction, strings.Join(spec.params, " or "))
+	}
+
+	encap := &netlink.SEG6LocalEncap{Action: spec.action}
+	encap.Flags[nl.SEG6_LOCAL_ACTION] = true
+	var err error
+	if command.OptionNh6 != "" {
+		if encap.In6Addr = net.ParseIP(command.OptionNh6); encap.In6Addr == nil || encap.In6Addr.To4() != nil {
+			return nil, fmt.Errorf("invalid nh6 %q", command.OptionNh6)
+		}
+		encap.Flags[nl.SEG6_LOCAL_NH6] = true
+	}
+	if command.OptionLocalTable != "" {
+		if encap.Table, err = getTableID(command, command.OptionLocalTable); err != nil {
+			return nil, err
+		}
+		encap.Flags[nl.SEG6_LOCAL_TABLE] = true
+	}
+	if command.OptionVrfTable != "" {
+		if encap.VrfTable, err = getTableID(command, command.OptionVrfTable); err != nil {
+			return nil, err
+		}
+		encap.Flags[nl.SEG6_LOCAL_VRFTABLE] = true
+	}
+	return encap, nil
+}
+
+// formatEncap formats encap of route as 'ip route show' does
+func formatEncap(encap netlink.Encap) string {
+	switch encap.(type) {
+	case *netlink.MPLSEncap:
+		return "mpls " + encap.String()
+	case *netlink.SEG6Encap:
+		return "seg6 " + encap.String()
+	case *netlink.SEG6LocalEncap:
+		return "seg6local " + encap.String()
+	}
+	return encap.String()
+}
+
+// getMplsRoute converts from CLI argument to netlink.Route of AF_MPLS.
+// Its nexthop is given as RTA_VIA and it is always in the main table.
+func getMplsRoute(command *parser.Command, linkIndex int, via net.IP, proto netlink.RouteProtocol) (route netlink.Route, err error) {
+	if command.OptionTable != "" || command.OptionVrf != "" || command.OptionScope != "" ||
+		command.OptionEncap != "" || command.IsOnlink {
+		return route, fmt.Errorf("mpls route does not support table, vrf, scope, encap and onlink")
+	}
+	label, err := getMplsLabel(command.OptionMpls)
+	if err != nil {
+		return route, err
+	}
+	route = netlink.Route{
+		LinkIndex: linkIndex,
+		MPLSDst:   &label,
+		Table:     unix.RT_TABLE_MAIN,
+		Protocol:  proto,
+	}
+	if via != nil {
+		family := netlink.FAMILY_V6
+		if via.To4() != nil {
+			family, via = netlink.FAMILY_V4, via.To4()
+		}
+		route.Via = &netlink.Via{AddrFamily: family, Addr: via}
+	}
+	if command.OptionAs != "" {
+		labels, err := getMplsLabels(command.OptionAs)
+		if err != nil {
+			return route, err
+		}
+		route.NewDst = &netlink.MPLSDestination{Labels: labels}
+	}
+	return route, nil
+}
+
+// checkMplsPlatformLabels checks that net.mpls.platform_labels of the
+// current namespace allows the label. The kernel does not accept any MPLS
+// route until it is set.
+func checkMplsPlatformLabels(label int, nsPath string) error {
+	val, err := readSysctl("/proc/sys/net/mpls/platform_labels")
+	if err != nil {
+		return fmt.Errorf("MPLS is not available in %s, net.mpls.platform_labels is not found (mpls_router module is not loaded?)", nsPath)
+	}
+	labels, err := strconv.Atoi(val)
+	if err != nil {
+		return fmt.Errorf("invalid net.mpls.platform_labels %q in %s", val, nsPath)
+	}
+	if label >= labels {
+		return fmt.Errorf("net.mpls.platform_labels is %d in %s, set it larger than label %d with 'sysctl set net.mpls.platform_labels'",
+			labels, nsPath, label)
+	}
+	return nil
+}
@@ -4,18 +4,44 @@ koro: Container ROuting tool
 package main
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"net"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 	"strconv"
 	"strings"
+	"syscall"
 	"github.com/redhat-nfvpe/koro/parser"
 	koko_api "github.com/redhat-nfvpe/koko/api"
 )
 
+// errUnchanged is returned when the target is already in the desired state
+// and it is allowed by --ignore-existing/--ignore-missing
+var errUnchanged = errors.New("unchanged")
+
+// isExisting checks whether add failed because the object already exists
+// and --ignore-existing is given
+func isExisting (command *parser.Command, err error) bool {
+	return command.IgnoreExisting && errors.Is(err, syscall.EEXIST)
+}
+
+// isGone checks whether del failed because the object does not exist
+func isGone (err error) bool {
+	return errors.Is(err, syscall.ESRCH) || errors.Is(err, syscall.ENOENT) ||
+		errors.Is(err, syscall.EADDRNOTAVAIL)
+}
+
+// isMissing checks whether del failed because the object does not exist
+// and --ignore-missing is given
+func isMissing (command *parser.Command, err error) bool {
+	return command.IgnoreMissing && isGone(err)
+}
+
 // getNamepace identify namespace from given cli option
 func getNamepace (command *parser.Command) (namespace string, err error) {
 	switch command.TargetType {
@@ -35,170 +61,845 @@ func getNamepace (command *parser.Command) (namespace string, err error) {
 	return namespace, err
 }
 
+// getTargetNS opens the network namespace which the command operates in
+func getTargetNS (command *parser.Command) (targetNS ns.NetNS, err error) {
+	if command.TargetType == parser.NSNONE {
+		return ns.GetCurrentNS()
+	}
+	nsName, err := getNamepace(command)
+	if err != nil {
+		return nil, err
+	}
+	return ns.GetNS(nsName)
+}
+
+// getTableID converts routing table given in CLI, id or name in rt_tables,
+// into table id
+func getTableID (command *parser.Command, table string) (id int, err error) {
+	if table[0] >= '0' && table[0] <= '9' {
+		id, err = strconv.Atoi(table)
+		if err != nil || id < 0 {
+			return 0, fmt.Errorf("invalid table %q", table)
+		}
+		return id, nil
+	}
+	return lookupTableName(command, table)
+}
+
+// routeProtocols are route protocol names which iproute2 knows
+var routeProtocols = map[string]int{
+	"redirect": 1,
+	"kernel": 2,
+	"boot": 3,
+	"static": 4,
+	"ra": 9,
+	"dhcp": 16,
+}
+
+// getProtocol converts route protocol given in CLI into protocol id
+func getProtocol (proto string) (id int, err error) {
+	if id, ok := routeProtocols[proto]; ok {
+		return id, nil
+	}
+	id, err = strconv.Atoi(proto)
+	if err != nil || id < 0 || id > 255 {
+		return 0, fmt.Errorf("invalid protocol %q", proto)
+	}
+	return id, nil
+}
+
+// scopes are route/address scope names which iproute2 knows
+var scopes = map[string]int{
+	"global": 0,
+	"site": 200,
+	"link": 253,
+	"host": 254,
+	"nowhere": 255,
+}
+
+// getScope converts scope given in CLI into scope id
+func getScope (scope string) (id int, err error) {
+	if id, ok := scopes[scope]; ok {
+		return id, nil
+	}
+	id, err = strconv.Atoi(scope)
+	if err != nil || id < 0 || id > 255 {
+		return 0, fmt.Errorf("invalid scope %q", scope)
+	}
+	return id, nil
+}
+
+// targetString describes the target namespace in messages
+func targetString (command *parser.Command) string {
+	switch command.TargetType {
+	case parser.DOCKER:
+		return "docker " + command.Target
+	case parser.IPNETNS:
+		return "ipnetns " + command.Target
+	case parser.NETNS:
+		return "netns " + command.Target
+	case parser.PID:
+		return "pid " + command.Target
+	}
+	return "current namespace"
+}
+
+// getGatewayLink returns the index of the link which the gateway is directly
+// connected to, looked up in the FIB of the namespace (or of the vrf).
+// linkIndex is 'dev' given in CLI, or 0 to find the link.
+func getGatewayLink (command *parser.Command, gw net.IP, linkIndex int) (int, error) {
+	options := &netlink.RouteGetOptions{OifIndex: linkIndex}
+	if linkIndex == 0 {
+		if gw.To4() == nil && gw.IsLinkLocalUnicast() {
+			return 0, fmt.Errorf("IPv6 link-local gateway %s requires dev", gw)
+		}
+		options.VrfName = command.OptionVrf
+	}
+	routes, err := netlink.RouteGetWithOptions(gw, options)
+	if err != nil || len(routes) == 0 || routes[0].Gw != nil ||
+		routes[0].Type != unix.RTN_UNICAST ||
+		(linkIndex != 0 && routes[0].LinkIndex != linkIndex) {
+		if linkIndex != 0 {
+			return 0, fmt.Errorf("gateway %s not directly reachable on %s in %s (onlink to force)",
+				gw, command.OptionDev, targetString(command))
+		}
+		return 0, fmt.Errorf("gateway %s not directly reachable in %s", gw, targetString(command))
+	}
+	return routes[0].LinkIndex, nil
+}
+
+// getRouteLink returns the index of 'dev' of route, or of the link to the
+// gateway if dev is omitted. It returns 0 if neither is given. The gateway is
+// checked unless it is onlink or the route is deleted.
+func getRouteLink (command *parser.Command, gw net.IP) (linkIndex int, err error) {
+	if command.OptionDev != "" {
+		link, err := netlink.LinkByName(command.OptionDev)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find link %q in %s: %v",
+				command.OptionDev, targetString(command), err)
+		}
+		linkIndex = link.Attrs().Index
+	} else if command.IsOnlink {
+		return 0, fmt.Errorf("onlink requires dev")
+	}
+	if gw == nil || command.IsOnlink || command.Operation == parser.ROUTEDEL {
+		return linkIndex, nil
+	}
+	if command.OptionMpls != "" && linkIndex != 0 {
+		// mpls nexthop on the given dev is not looked up in the FIB
+		return linkIndex, nil
+	}
+	return getGatewayLink(command, gw, linkIndex)
+}
+
+// getRouteDst converts the prefix of route given in CLI into IPNet. The
+// address without length is the host route, and host bits of the prefix are
+// cleared with a warning.
+func getRouteDst (command *parser.Command) (*net.IPNet, error) {
+	length := command.NetworkLength
+	if length == "" {
+		length = "32"
+		if strings.Contains(command.Network, ":") {
+			length = "128"
+		}
+	}
+	prefix := fmt.Sprintf("%s/%s", command.Network, length)
+	ip, dst, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix %q", prefix)
+	}
+	if len(dst.IP) == net.IPv6len && dst.IP.To4() != nil {
+		// netlink takes it as IPv4
+		return nil, fmt.Errorf("IPv4-mapped IPv6 prefix %q is not supported", prefix)
+	}
+	if !ip.Equal(dst.IP) {
+		fmt.Fprintf(os.Stderr, "warning: %s is normalized to %s\n", prefix, dst)
+	}
+	return dst, nil
+}
+
 // GetNetlinkRoute converts from CLI argument to netlink.Route structure
 func GetNetlinkRoute (command *parser.Command) (route netlink.Route, err error) {
 	var optionDevIfIndex int
 	var optionViaAddress net.IP
+	var optionTable, optionProto, optionScope int
 
-	optionViaAddress = net.ParseIP(command.OptionVia)
-	if optionViaAddress == nil {
-		fmt.Fprintf(os.Stderr, "Failed to parse IP")
+	if command.OptionTable != "" {
+		optionTable, err = getTableID(command, command.OptionTable)
+		if err != nil {
+			return route, err
+		}
 	}
-
-	if command.OptionDev == "" {
-		routeToViaIP, err1 := netlink.RouteGet(optionViaAddress)
-		if err1 != nil {
-			fmt.Fprintf(os.Stderr, "%v", err1)
+	if command.OptionVrf != "" {
+		if command.OptionTable != "" {
+			return route, fmt.Errorf("vrf and table are exclusive")
 		}
-		if len(routeToViaIP) == 0 {
-			fmt.Fprintf(os.Stderr, "no Route to via address")
+		optionTable, err = getVrfTable(command.OptionVrf)
+		if err != nil {
+			return route, err
 		}
-		optionDevIfIndex = routeToViaIP[0].LinkIndex
-	} else {
-		optionDevIf, err2 := netlink.LinkByName(command.OptionDev)
-		if err2 != nil {
-			fmt.Fprintf(os.Stderr, "%v", err2)
+	}
+	if command.OptionProto != "" {
+		optionProto, err = getProtocol(command.OptionProto)
+		if err != nil {
+			return route, err
+		}
+	}
+	if command.OptionScope != "" {
+		optionScope, err = getScope(command.OptionScope)
+		if err != nil {
+			return route, err
 		}
-		optionDevIfIndex = optionDevIf.Attrs().Index
 	}
 
-	if command.IsDefault {
-		route = netlink.Route{
-			LinkIndex: optionDevIfIndex,
-			Gw: optionViaAddress,
-			Dst: nil,
+	var optionDst *net.IPNet
+	if command.OptionMpls == "" && !command.IsDefault {
+		if optionDst, err = getRouteDst(command); err != nil {
+			return route, err
 		}
-	} else {
-		network, netmask, err3 := net.ParseCIDR(
-			fmt.Sprintf("%s/%s", command.Network, command.NetworkLength))
-		if err3 != nil {
-			return route, err3
+	}
+	if command.OptionVia != "" {
+		if optionViaAddress, err = getViaAddress(command, getViaFamily(command, optionDst)); err != nil {
+			return route, err
 		}
-
-		ipnet := net.IPNet {
-			IP: network,
-			Mask: netmask.Mask,
+		if command.IsViaInet6 {
+			if ipFamily(optionViaAddress) != netlink.FAMILY_V6 {
+				return route, fmt.Errorf("invalid via inet6 %q", command.OptionVia)
+			}
+			if command.OptionMpls != "" {
+				return route, fmt.Errorf("via inet6 is not for mpls route")
+			}
+		} else if optionDst != nil && ipFamily(optionDst.IP) != ipFamily(optionViaAddress) {
+			return route, fmt.Errorf("address family mismatch between %s and via %s",
+				optionDst, optionViaAddress)
 		}
-		route = netlink.Route{
-			LinkIndex: optionDevIfIndex,
-			Dst: &ipnet,
-			Gw: optionViaAddress,
+	}
+	if command.IsOnlink && optionViaAddress == nil {
+		return route, fmt.Errorf("onlink requires via")
+	}
+	optionDevIfIndex, err = getRouteLink(command, optionViaAddress)
+	if err != nil {
+		return route, err
+	}
+	if optionDevIfIndex == 0 && command.Operation != parser.ROUTEDEL {
+		return route, fmt.Errorf("route requires via or dev")
+	}
+
+	if command.OptionMpls != "" {
+		return getMplsRoute(command, optionDevIfIndex, optionViaAddress,
+			netlink.RouteProtocol(optionProto))
+	}
+	if command.OptionAs != "" {
+		return route, fmt.Errorf("as is only for mpls route")
+	}
+
+	route = netlink.Route{
+		LinkIndex: optionDevIfIndex,
+		Dst: optionDst,
+		Gw: optionViaAddress,
+		Table: optionTable,
+		Protocol: netlink.RouteProtocol(optionProto),
+		Scope: netlink.Scope(optionScope),
+	}
+	if command.IsViaInet6 && (command.IsDefault ||
+		(optionDst != nil && ipFamily(optionDst.IP) == netlink.FAMILY_V4)) {
+		// IPv4 route via IPv6 nexthop (RFC 5549) is given as RTA_VIA, and
+		// default is IPv4 as iproute2 does
+		if command.IsDefault {
+			route.Dst = &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)}
 		}
+		route.Gw = nil
+		route.Via = &netlink.Via{AddrFamily: netlink.FAMILY_V6, Addr: optionViaAddress}
 	}
 
+	if command.IsOnlink {
+		route.Flags = int(netlink.FLAG_ONLINK)
+	}
+	if route.Encap, err = getRouteEncap(command); err != nil {
+		return route, err
+	}
+	if command.OptionEncap == "seg6local" {
+		if command.OptionDev == "" {
+			return route, fmt.Errorf("seg6local requires dev")
+		}
+		if route.Dst == nil || route.Dst.IP.To4() != nil {
+			return route, fmt.Errorf("seg6local requires IPv6 SID")
+		}
+	}
 	return route, nil
 }
 
-// AddDelRoute does actuall operation to add/del route with netlink API
+// AddDelRoute does actuall operation to add/del/replace/change route with
+// netlink API
 func AddDelRoute (command *parser.Command) (err error) {
-	var (
-		nsName string
-		targetNS ns.NetNS
-	)
-
-	if command.TargetType == parser.NSNONE {
-		targetNS, err = ns.GetCurrentNS()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
-			return err
-		}
-	} else {
-		nsName, err = getNamepace(command)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
-			return err
-		}
-		targetNS, err = ns.GetNS(nsName)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
-			return err
-		}
-		defer targetNS.Close()
+	if command.FlushConntrack && command.Operation != parser.ROUTEREPLACE &&
+		command.Operation != parser.ROUTECHANGE {
+		return fmt.Errorf("--flush-conntrack is only for route replace/change")
 	}
+	if command.FlushConntrack && command.OptionMpls != "" {
+		return fmt.Errorf("--flush-conntrack is not for mpls route")
+	}
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v", err)
+		return err
+	}
+	defer targetNS.Close()
 
 	err = targetNS.Do(func(_ ns.NetNS) error {
 		route, err1 := GetNetlinkRoute(command)
 		if err1 != nil {
 			return err1
 		}
+		if route.MPLSDst != nil && command.Operation != parser.ROUTEDEL {
+			if err1 = checkMplsPlatformLabels(*route.MPLSDst, targetNS.Path()); err1 != nil {
+				return err1
+			}
+		}
+		routeAdd, routeReplace, routeChange := netlink.RouteAdd, netlink.RouteReplace, netlink.RouteChange
+		options, err1 := GetIPv6RouteOptions(command, &route)
+		if err1 != nil {
+			return err1
+		}
+		if options != nil {
+			// netlink.Route does not have pref/expires
+			routeAdd = func(r *netlink.Route) error {
+				return options.modify(r, unix.NLM_F_CREATE|unix.NLM_F_EXCL)
+			}
+			routeReplace = func(r *netlink.Route) error {
+				return options.modify(r, unix.NLM_F_CREATE|unix.NLM_F_REPLACE)
+			}
+			routeChange = func(r *netlink.Route) error {
+				return options.modify(r, unix.NLM_F_REPLACE)
+			}
+		}
 		switch command.Operation {
 		case parser.ROUTEADD :
-			if err2 := netlink.RouteAdd(&route); err2 != nil {
+			if err2 := routeAdd(&route); err2 != nil {
+				if isExisting(command, err2) {
+					return errUnchanged
+				}
 				return err2
 			}
 		case parser.ROUTEDEL:
 			if err2 := netlink.RouteDel(&route); err2 != nil {
+				if isMissing(command, err2) {
+					return errUnchanged
+				}
+				return err2
+			}
+		case parser.ROUTEREPLACE:
+			if err2 := routeReplace(&route); err2 != nil {
+				return err2
+			}
+		case parser.ROUTECHANGE:
+			if err2 := routeChange(&route); err2 != nil {
 				return err2
 			}
 		}
+		if command.FlushConntrack {
+			return flushRouteConntrack(&route)
+		}
 		// call netlink.RouteAdd
 		// add 1.1.1.0/24 via 192.168.1.1
 		// add 1.1.2.0/24 dev eth0
 		// add 1.1.3.0/24 via 192.168.1.1 dev eth0
 		return nil
 	})
-	return nil
+	return err
 }
 
-// AddDelAddr adds/deletes address with netlink API
-func AddDelAddr (command *parser.Command) (err error) {
-	var (
-		nsName string
-		targetNS ns.NetNS
-	)
+// FlushRoute deletes routes which match with given table/dev/proto
+func FlushRoute (command *parser.Command) (err error) {
+	if command.OptionVia != "" || command.OptionScope != "" || command.OptionVrf != "" {
+		return fmt.Errorf("route flush supports only table, dev and proto")
+	}
 
-	if command.TargetType == parser.NSNONE {
-		targetNS, err = ns.GetCurrentNS()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
+	filter := &netlink.Route{Table: syscall.RT_TABLE_MAIN}
+	filterMask := netlink.RT_FILTER_TABLE
+	if command.OptionTable == "all" {
+		filter.Table = syscall.RT_TABLE_UNSPEC
+	} else if command.OptionTable != "" {
+		if filter.Table, err = getTableID(command, command.OptionTable); err != nil {
 			return err
 		}
-	} else {
-		nsName, err = getNamepace(command)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
-			return err
+	}
+	if command.OptionProto != "" {
+		proto, err1 := getProtocol(command.OptionProto)
+		if err1 != nil {
+			return err1
+		}
+		filter.Protocol = netlink.RouteProtocol(proto)
+		filterMask |= netlink.RT_FILTER_PROTOCOL
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		if command.OptionDev != "" {
+			optionDevIf, err1 := netlink.LinkByName(command.OptionDev)
+			if err1 != nil {
+				return err1
+			}
+			filter.LinkIndex = optionDevIf.Attrs().Index
+			filterMask |= netlink.RT_FILTER_OIF
+		}
+
+		// same as iproute2, flush only IPv4 routes by default not to remove
+		// fe80::/64 of the kernel
+		families := []int{netlink.FAMILY_V4}
+		switch command.OptionFamily {
+		case "inet6":
+			families = []int{netlink.FAMILY_V6}
+		case "all":
+			families = []int{netlink.FAMILY_V4, netlink.FAMILY_V6}
+		}
+		var routes []netlink.Route
+		for _, family := range families {
+			list, err1 := netlink.RouteListFiltered(family, filter, filterMask)
+			if err1 != nil {
+				return err1
+			}
+			routes = append(routes, list...)
+		}
+		count := 0
+		for _, route := range routes {
+			if err2 := netlink.RouteDel(&route); err2 != nil {
+				if isGone(err2) {
+					continue
+				}
+				return fmt.Errorf("failed to delete route %v (%d routes flushed): %v",
+					route, count, err2)
+			}
+			count++
+		}
+		fmt.Printf("%d routes flushed\n", count)
+		return nil
+	})
+}
+
+// formatRoute formats route as 'ip route show' does
+func formatRoute (route netlink.Route) string {
+	var b strings.Builder
+
+	switch {
+	case route.MPLSDst != nil:
+		fmt.Fprintf(&b, "%d", *route.MPLSDst)
+	case route.Dst == nil:
+		b.WriteString("default")
+	default:
+		b.WriteString(route.Dst.String())
+	}
+	if route.NewDst != nil {
+		fmt.Fprintf(&b, " as %s", route.NewDst)
+	}
+	if route.Encap != nil {
+		fmt.Fprintf(&b, " encap %s", formatEncap(route.Encap))
+	}
+	if route.Gw != nil {
+		fmt.Fprintf(&b, " via %s", route.Gw)
+	}
+	if via, ok := route.Via.(*netlink.Via); ok {
+		if route.MPLSDst == nil && via.AddrFamily == netlink.FAMILY_V6 {
+			fmt.Fprintf(&b, " via inet6 %s", via.Addr)
+		} else {
+			fmt.Fprintf(&b, " via %s", via.Addr)
+		}
+	}
+	if link, err := netlink.LinkByIndex(route.LinkIndex); err == nil {
+		fmt.Fprintf(&b, " dev %s", link.Attrs().Name)
+	}
+	proto := strconv.Itoa(int(route.Protocol))
+	for name, id := range routeProtocols {
+		if id == int(route.Protocol) {
+			proto = name
+		}
+	}
+	fmt.Fprintf(&b, " proto %s", proto)
+	if route.Scope != netlink.SCOPE_UNIVERSE {
+		scope := strconv.Itoa(int(route.Scope))
+		for name, id := range scopes {
+			if id == int(route.Scope) {
+				scope = name
+			}
+		}
+		fmt.Fprintf(&b, " scope %s", scope)
+	}
+	if route.Src != nil {
+		fmt.Fprintf(&b, " src %s", route.Src)
+	}
+	if route.Table != syscall.RT_TABLE_MAIN {
+		fmt.Fprintf(&b, " table %d", route.Table)
+	}
+	return b.String()
+}
+
+// listRoutes returns routes in the table of the namespace. table 0 means
+// all tables.
+func listRoutes (table int, linkIndex int) (routes []netlink.Route, err error) {
+	filter := &netlink.Route{Table: table, LinkIndex: linkIndex}
+	filterMask := netlink.RT_FILTER_TABLE
+	if linkIndex != 0 {
+		filterMask |= netlink.RT_FILTER_OIF
+	}
+	return netlink.RouteListFiltered(netlink.FAMILY_ALL, filter, filterMask)
+}
+
+// ShowRoute shows routes which match with given table/vrf/dev
+func ShowRoute (command *parser.Command) (err error) {
+	if command.OptionVia != "" || command.OptionProto != "" || command.OptionScope != "" {
+		return fmt.Errorf("route show supports only table, vrf and dev")
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		table := syscall.RT_TABLE_MAIN
+		var err1 error
+		switch {
+		case command.OptionVrf != "" && command.OptionTable != "":
+			return fmt.Errorf("vrf and table are exclusive")
+		case command.OptionVrf != "":
+			if table, err1 = getVrfTable(command.OptionVrf); err1 != nil {
+				return err1
+			}
+		case command.OptionTable == "all":
+			table = syscall.RT_TABLE_UNSPEC
+		case command.OptionTable != "":
+			if table, err1 = getTableID(command, command.OptionTable); err1 != nil {
+				return err1
+			}
 		}
-		targetNS, err = ns.GetNS(nsName)
+		linkIndex := 0
+		if command.OptionDev != "" {
+			optionDevIf, err1 := netlink.LinkByName(command.OptionDev)
+			if err1 != nil {
+				return err1
+			}
+			linkIndex = optionDevIf.Attrs().Index
+		}
+
+		routes, err1 := listRoutes(table, linkIndex)
+		if err1 != nil {
+			return err1
+		}
+		for _, route := range routes {
+			fmt.Println(formatRoute(route))
+		}
+		return nil
+	})
+}
+
+// GetRouteGetOptions converts from CLI argument of 'route get' to the
+// destination and options of the FIB lookup
+func GetRouteGetOptions (command *parser.Command) (dst net.IP, options *netlink.RouteGetOptions, err error) {
+	dst = net.ParseIP(command.OptionTo)
+	if dst == nil {
+		return nil, nil, fmt.Errorf("invalid address %q", command.OptionTo)
+	}
+	if command.OptionIif != "" && command.OptionFrom == "" {
+		return nil, nil, fmt.Errorf("iif requires from")
+	}
+	options = &netlink.RouteGetOptions{Iif: command.OptionIif}
+	if command.OptionFrom != "" {
+		options.SrcAddr = net.ParseIP(command.OptionFrom)
+		if options.SrcAddr == nil {
+			return nil, nil, fmt.Errorf("invalid from %q", command.OptionFrom)
+		}
+		if ipFamily(options.SrcAddr) != ipFamily(dst) {
+			return nil, nil, fmt.Errorf("address family mismatch between %s and from %s",
+				dst, options.SrcAddr)
+		}
+	}
+	if command.OptionFwmark != "" {
+		mark, err := strconv.ParseUint(command.OptionFwmark, 0, 32)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "%v", err)
-			return err
+			return nil, nil, fmt.Errorf("invalid mark %q", command.OptionFwmark)
 		}
-		defer targetNS.Close()
+		options.Mark = uint32(mark)
 	}
+	return dst, options, nil
+}
 
-	if command.OptionVia != "" {
-		return fmt.Errorf("address command does not support via keyword")
+// formatRouteGet formats the result of FIB lookup as 'ip route get' does
+func formatRouteGet (dst net.IP, options *netlink.RouteGetOptions, route netlink.Route) string {
+	var b strings.Builder
+
+	switch route.Type {
+	case unix.RTN_LOCAL:
+		b.WriteString("local ")
+	case unix.RTN_BROADCAST:
+		b.WriteString("broadcast ")
+	case unix.RTN_MULTICAST:
+		b.WriteString("multicast ")
+	}
+	b.WriteString(dst.String())
+	if options.SrcAddr != nil {
+		fmt.Fprintf(&b, " from %s", options.SrcAddr)
+	}
+	if route.Gw != nil {
+		fmt.Fprintf(&b, " via %s", route.Gw)
+	}
+	if link, err := netlink.LinkByIndex(route.LinkIndex); err == nil {
+		fmt.Fprintf(&b, " dev %s", link.Attrs().Name)
+	}
+	if route.Src != nil {
+		fmt.Fprintf(&b, " src %s", route.Src)
+	}
+	if route.Table != syscall.RT_TABLE_MAIN {
+		fmt.Fprintf(&b, " table %d", route.Table)
+	}
+	if options.Iif != "" {
+		fmt.Fprintf(&b, " iif %s", options.Iif)
+	}
+	if options.Mark != 0 {
+		fmt.Fprintf(&b, " mark 0x%x", options.Mark)
+	}
+	return b.String()
+}
+
+// GetRoute looks up the route of the address in the FIB of the namespace, as
+// 'ip route get' does
+func GetRoute (command *parser.Command) (err error) {
+	dst, options, err := GetRouteGetOptions(command)
+	if err != nil {
+		return err
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		routes, err1 := netlink.RouteGetWithOptions(dst, options)
+		if err1 != nil {
+			return fmt.Errorf("failed to get route to %s: %v", dst, err1)
+		}
+		for _, route := range routes {
+			fmt.Println(formatRouteGet(dst, options, route))
+		}
+		return nil
+	})
+}
+
+// infinityLifetime is the lifetime of 'forever', as INFINITY_LIFE_TIME of
+// the kernel
+const infinityLifetime = math.MaxUint32
+
+// getLifetime converts address lifetime given in CLI into seconds
+func getLifetime (lft string) (sec uint32, err error) {
+	if lft == "forever" {
+		return infinityLifetime, nil
+	}
+	val, err := strconv.ParseUint(lft, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lifetime %q", lft)
+	}
+	return uint32(val), nil
+}
+
+// GetNetlinkAddr converts from CLI argument to netlink.Addr structure
+func GetNetlinkAddr (command *parser.Command) (addr *netlink.Addr, err error) {
+	if command.OptionDev == "" {
+		return nil, fmt.Errorf("address command requires dev")
 	}
-	//Add check (no via, dev is valid)
 	ip, mask, err := net.ParseCIDR(
 		fmt.Sprintf("%s/%s", command.Network, command.NetworkLength))
+	if err != nil {
+		return nil, err
+	}
+	addr = &netlink.Addr{IPNet: &net.IPNet{IP: ip, Mask: mask.Mask}, Label: command.OptionLabel}
+	isIPv4 := ip.To4() != nil
+
+	if command.OptionPeer != "" {
+		peer := command.OptionPeer
+		if !strings.Contains(peer, "/") {
+			peer = fmt.Sprintf("%s/%d", peer, len(mask.Mask) * 8)
+		}
+		peerIP, peerNet, err1 := net.ParseCIDR(peer)
+		if err1 != nil || (peerIP.To4() != nil) != isIPv4 {
+			return nil, fmt.Errorf("invalid peer %q", command.OptionPeer)
+		}
+		addr.Peer = &net.IPNet{IP: peerIP, Mask: peerNet.Mask}
+	}
+	if command.OptionBroadcast != "" && command.OptionBroadcast != "+" {
+		if !isIPv4 {
+			return nil, fmt.Errorf("broadcast is supported only for IPv4")
+		}
+		if addr.Broadcast = net.ParseIP(command.OptionBroadcast).To4(); addr.Broadcast == nil {
+			return nil, fmt.Errorf("invalid broadcast %q", command.OptionBroadcast)
+		}
+	}
+	if command.OptionLabel != "" && !isIPv4 {
+		return nil, fmt.Errorf("label is supported only for IPv4")
+	}
+	if command.OptionScope != "" {
+		if addr.Scope, err = getScope(command.OptionScope); err != nil {
+			return nil, err
+		}
+	}
+
+	// same as iproute2, preferred_lft defaults to valid_lft, and valid_lft
+	// defaults to forever
+	if command.OptionValidLft != "" || command.OptionPreferredLft != "" {
+		validLft := uint32(infinityLifetime)
+		if command.OptionValidLft != "" {
+			if validLft, err = getLifetime(command.OptionValidLft); err != nil {
+				return nil, err
+			}
+		}
+		preferredLft := validLft
+		if command.OptionPreferredLft != "" {
+			if preferredLft, err = getLifetime(command.OptionPreferredLft); err != nil {
+				return nil, err
+			}
+		}
+		if preferredLft > validLft {
+			return nil, fmt.Errorf("preferred_lft is greater than valid_lft")
+		}
+		// netlink sends them as uint32, so forever wraps to -1 on 32bit
+		addr.ValidLft, addr.PreferedLft = int(validLft), int(preferredLft)
+	}
+
+	if command.IsNodad {
+		addr.Flags |= unix.IFA_F_NODAD
+	}
+	if command.IsNoprefixroute {
+		addr.Flags |= unix.IFA_F_NOPREFIXROUTE
+	}
+	if command.IsHome {
+		addr.Flags |= unix.IFA_F_HOMEADDRESS
+	}
+	if command.IsMngtmpaddr {
+		addr.Flags |= unix.IFA_F_MANAGETEMPADDR
+	}
+	if isIPv4 && addr.Flags & (unix.IFA_F_NODAD | unix.IFA_F_HOMEADDRESS | unix.IFA_F_MANAGETEMPADDR) != 0 {
+		return nil, fmt.Errorf("nodad, home and mngtmpaddr are supported only for IPv6")
+	}
+	return addr, nil
+}
+
+// AddDelAddr adds/deletes address with netlink API
+func AddDelAddr (command *parser.Command) (err error) {
+	addr, err := GetNetlinkAddr(command)
 	if err != nil {
 		return err
 	}
 
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v", err)
+		return err
+	}
+	defer targetNS.Close()
+
 	err = targetNS.Do(func(_ ns.NetNS) error {
 		optionDevIf, err2 := netlink.LinkByName(command.OptionDev)
 		if err2 != nil {
 			return err2
 		}
-		addr := &netlink.Addr{IPNet: &net.IPNet{IP: ip, Mask: mask.Mask}, Label: ""}
 
 		switch command.Operation {
 		case parser.ADDRADD:
 			if err3 := netlink.AddrAdd(optionDevIf, addr); err3 != nil {
+				if isExisting(command, err3) {
+					return errUnchanged
+				}
 				return fmt.Errorf("failed to add IP addr %v to %q: %v",
                                         addr, command.OptionDev, err3)
 			}
 		case parser.ADDRDEL:
 			if err3 := netlink.AddrDel(optionDevIf, addr); err3 != nil {
+				if isMissing(command, err3) {
+					return errUnchanged
+				}
 				return fmt.Errorf("failed to delete IP addr %v to %q: %v",
                                         addr, command.OptionDev, err3)
 			}
 		}
 		return nil
 	})
-	return nil
+	return err
+}
+
+// FlushAddr deletes addresses on given dev, which match with given scope
+func FlushAddr (command *parser.Command) (err error) {
+	if command.OptionDev == "" {
+		return fmt.Errorf("address flush requires dev")
+	}
+	if command.OptionVia != "" || command.OptionTable != "" || command.OptionProto != "" {
+		return fmt.Errorf("address flush supports only dev and scope")
+	}
+	optionScope := -1
+	if command.OptionScope != "" {
+		if optionScope, err = getScope(command.OptionScope); err != nil {
+			return err
+		}
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		optionDevIf, err1 := netlink.LinkByName(command.OptionDev)
+		if err1 != nil {
+			return err1
+		}
+		addrs, err1 := netlink.AddrList(optionDevIf, netlink.FAMILY_ALL)
+		if err1 != nil {
+			return err1
+		}
+		count := 0
+		for _, addr := range addrs {
+			if optionScope >= 0 && addr.Scope != optionScope {
+				continue
+			}
+			// secondary addresses may be gone with its primary one
+			if err2 := netlink.AddrDel(optionDevIf, &addr); err2 != nil {
+				if isGone(err2) {
+					continue
+				}
+				return fmt.Errorf("failed to delete IP addr %v (%d addresses flushed): %v",
+					addr, count, err2)
+			}
+			count++
+		}
+		fmt.Printf("%d addresses flushed\n", count)
+		return nil
+	})
+}
+
+// showResult shows the result of the operation which modifies the target
+func showResult (err error) {
+	switch {
+	case err == nil:
+		fmt.Println("Succeed!")
+	case errors.Is(err, errUnchanged):
+		fmt.Println("unchanged")
+	default:
+		showError(err)
+	}
+}
+
+// showError shows the error of the command, and exits with 1 if any
+func showError (err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "err:%v", err)
+		os.Exit(1)
+	}
 }
 
 // usage shows usage when user does not provide any arguments
@@ -206,6 +907,31 @@ func usage() {
 	doc := heredoc.Doc(`
 		Example:
 		./koro docker <name> address add 127.0.0.3/24 dev lo
+		./koro docker <name> rule add from 10.1.1.0/24 table 100
+		./koro docker <name> sysctl set net.ipv4.ip_forward 1
+		./koro docker <name> nat masquerade src 10.1.1.0/24 oif eth0
+		./koro docker <name> qdisc add dev eth0 root netem delay 100ms jitter 10ms loss 1%
+		./koro docker <name> link set eth1 mtu 9000 up
+		./koro link add veth eth1 docker <name1> peer eth1 docker <name2>
+		./koro docker <name> link add vlan eth0.100 link eth0 id 100 name eth1 up
+		./koro docker <name> link add vxlan vx0 id 100 remote 192.168.1.2 dev eth0 up
+		./koro docker <name> link adopt ens1f0v0 name net1 keepaddr keepstate
+		./koro docker <name> link add bridge br0 stp on up
+		./koro docker <name> link set eth1 master br0
+		./koro docker <name> link add vrf mgmt table 10 up
+		./koro docker <name> route add 10.1.1.0/24 via 10.1.1.1 vrf mgmt
+		./koro docker <name> route add 10.1.2.0/24 via 192.168.1.1 dev eth0 onlink
+		./koro docker <name> route add 10.1.3.5 via 10.1.1.1
+		./koro docker <name> route add 2001:db8::/32 via fe80::1 dev eth0 pref high expires 300
+		./koro docker <name> route add 10.1.4.0/24 via inet6 fe80::1 dev eth0
+		./koro docker <name> route add 10.1.5.0/24 via docker:<peer>@eth1
+		./koro docker <name> route get 8.8.8.8 from 10.1.1.2 mark 0x10
+		./koro docker <name> route add 10.2.1.0/24 encap mpls 100/200 via 10.1.1.1
+		./koro docker <name> route add 10.3.1.0/24 encap seg6 mode encap segs fc00::1,fc00::2 dev eth0
+		./koro docker <name> route add fc00::100/128 encap seg6local action End.DT6 table 100 dev eth0
+		./koro docker <name> neighbor add 10.1.1.1 lladdr 02:00:00:00:00:01 dev eth0
+		./koro --ignore-existing docker <name> route add 10.1.1.0/24 via 10.1.1.1
+		./koro --flush-conntrack docker <name> route replace 10.1.1.0/24 via 10.1.1.2
 	`)
 	fmt.Print(doc)
 }
@@ -219,18 +945,56 @@ func main () {
 	p := parser.ParseCommand(args)
 
 	c := p.GetCommand()
+	if c.IsError {
+		os.Exit(1)
+	}
 	switch c.Operation {
-	case parser.ROUTEADD, parser.ROUTEDEL:
-		if err := AddDelRoute(c); err != nil {
-			fmt.Fprintf(os.Stderr, "err:%v", err)
-		} else {
-			fmt.Println("Succeed!")
-		}
+	case parser.ROUTEADD, parser.ROUTEDEL, parser.ROUTEREPLACE, parser.ROUTECHANGE:
+		showResult(AddDelRoute(c))
+	case parser.ROUTEFLUSH:
+		showError(FlushRoute(c))
+	case parser.ROUTESHOW:
+		showError(ShowRoute(c))
+	case parser.ROUTEGET:
+		showError(GetRoute(c))
 	case parser.ADDRADD, parser.ADDRDEL:
-		if err := AddDelAddr(c); err != nil {
-			fmt.Fprintf(os.Stderr, "err:%v", err)
-		} else {
-			fmt.Println("Succeed!")
-		}
+		showResult(AddDelAddr(c))
+	case parser.ADDRFLUSH:
+		showError(FlushAddr(c))
+	case parser.RULEADD, parser.RULEDEL:
+		showResult(AddDelRule(c))
+	case parser.RULESHOW:
+		showError(ShowRule(c))
+	case parser.LINKADD:
+		showResult(AddLink(c))
+	case parser.LINKSET:
+		showResult(SetLink(c))
+	case parser.VETHADD:
+		showResult(AddVeth(c))
+	case parser.LINKSHOW:
+		showError(ShowLink(c))
+	case parser.VRFSHOW:
+		showError(ShowVrf(c))
+	case parser.QDISCADD, parser.QDISCREPLACE, parser.QDISCDEL,
+		parser.CLASSADD, parser.CLASSREPLACE, parser.CLASSDEL:
+		showResult(AddDelQdisc(c))
+	case parser.QDISCSHOW, parser.CLASSSHOW:
+		showError(ShowQdisc(c))
+	case parser.NATMASQUERADE, parser.NATSNAT, parser.NATDNAT, parser.FILTERADD:
+		showResult(AddNftRule(c))
+	case parser.NATSHOW, parser.NATFLUSH, parser.FILTERSHOW, parser.FILTERFLUSH:
+		showError(ShowFlushNft(c))
+	case parser.CONNTRACKSHOW, parser.CONNTRACKFLUSH:
+		showError(ShowFlushConntrack(c))
+	case parser.SYSCTLGET:
+		showError(GetSetSysctl(c))
+	case parser.SYSCTLSET:
+		showResult(GetSetSysctl(c))
+	case parser.LINKADOPT, parser.LINKRELEASE:
+		showResult(AdoptReleaseLink(c))
+	case parser.NEIGHADD, parser.NEIGHDEL, parser.NEIGHREPLACE:
+		showResult(AddDelNeigh(c))
+	case parser.NEIGHSHOW, parser.NEIGHFLUSH:
+		showError(ShowFlushNeigh(c))
 	}
 }
@@ -1,7 +1,17 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"github.com/google/nftables/expr"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
 	"github.com/redhat-nfvpe/koro/parser"
 )
 
@@ -12,10 +22,608 @@ func TestGetNetlinkRoute(t *testing.T) {
 		NetworkLength: "24",
 		OptionVia: "127.0.0.1",
 		OptionDev: "lo",
+		IsOnlink: true,
 	}
 	route, err1 := GetNetlinkRoute(&command1)
-	if (err1 != nil || route.LinkIndex == 0) {
+	if (err1 != nil || route.LinkIndex == 0 || route.Flags != int(netlink.FLAG_ONLINK)) {
 		t.Fatalf("Parse error: %v/%v", route, err1)
 	}
 
+	command1.Network = "192.168.1.5"
+	command1.NetworkLength = ""
+	route, err1 = GetNetlinkRoute(&command1)
+	if ones, _ := route.Dst.Mask.Size(); err1 != nil || ones != 32 {
+		t.Fatalf("Parse error: %v/%v", route, err1)
+	}
+	command1.NetworkLength = "24"
+	route, err1 = GetNetlinkRoute(&command1)
+	if err1 != nil || route.Dst.String() != "192.168.1.0/24" {
+		t.Fatalf("Parse error: %v/%v", route, err1)
+	}
+	command1.Network = "fc00::"
+	if route, err := GetNetlinkRoute(&command1); err == nil {
+		t.Fatalf("family mismatch should fail: %v", route)
+	}
+	command1.Network = "::ffff:192.168.1.0"
+	command1.NetworkLength = "120"
+	if route, err := GetNetlinkRoute(&command1); err == nil {
+		t.Fatalf("IPv4-mapped prefix should fail: %v", route)
+	}
+	command1.Network = "192.168.1.0"
+	command1.NetworkLength = "24"
+
+	command1.OptionVia = "fc00::1"
+	command1.IsViaInet6 = true
+	route, err1 = GetNetlinkRoute(&command1)
+	if via, ok := route.Via.(*netlink.Via); err1 != nil || route.Gw != nil || !ok ||
+		via.AddrFamily != netlink.FAMILY_V6 || !via.Addr.Equal(net.ParseIP("fc00::1")) {
+		t.Fatalf("Parse error: %v/%v", route, err1)
+	}
+	command1.IsDefault = true
+	route, err1 = GetNetlinkRoute(&command1)
+	if via, ok := route.Via.(*netlink.Via); err1 != nil || route.Gw != nil || !ok ||
+		route.Dst.String() != "0.0.0.0/0" || via.AddrFamily != netlink.FAMILY_V6 {
+		t.Fatalf("Parse error: %v/%v", route, err1)
+	}
+	command1.IsDefault = false
+	command1.OptionVia = "127.0.0.1"
+	command1.IsViaInet6 = false
+
+	command1.IsOnlink = false
+	if route, err := GetNetlinkRoute(&command1); err == nil {
+		t.Fatalf("local gateway should fail: %v", route)
+	}
+	command1.OptionDev = ""
+	command1.OptionVia = "fe80::1"
+	if route, err := GetNetlinkRoute(&command1); err == nil {
+		t.Fatalf("link-local gateway without dev should fail: %v", route)
+	}
+	command1.OptionVia = "invalid"
+	if route, err := GetNetlinkRoute(&command1); err == nil {
+		t.Fatalf("invalid gateway should fail: %v", route)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.ROUTEADD,
+		OptionMpls: "100",
+		OptionVia: "127.0.0.1",
+		OptionDev: "lo",
+		OptionAs: "200/300",
+	}
+	route, err2 := GetNetlinkRoute(&command2)
+	via, ok := route.Via.(*netlink.Via)
+	if (err2 != nil || route.MPLSDst == nil || *route.MPLSDst != 100 || route.Gw != nil ||
+		!ok || via.AddrFamily != netlink.FAMILY_V4 || route.NewDst.String() != "200/300") {
+		t.Fatalf("Parse error: %v/%v", route, err2)
+	}
+
+	command3 := parser.Command{
+		Operation: parser.ROUTEADD,
+		Network: "192.168.1.0",
+		NetworkLength: "24",
+		OptionVia: "127.0.0.1",
+		OptionDev: "lo",
+		IsOnlink: true,
+		OptionEncap: "mpls",
+		OptionLabels: "100/200",
+	}
+	route, err3 := GetNetlinkRoute(&command3)
+	if encap, ok := route.Encap.(*netlink.MPLSEncap); err3 != nil || !ok || len(encap.Labels) != 2 {
+		t.Fatalf("Parse error: %v/%v", route, err3)
+	}
+
+	command4 := parser.Command{
+		Operation: parser.ROUTEADD,
+		Network: "192.168.1.0",
+		NetworkLength: "24",
+		OptionDev: "lo",
+		OptionEncap: "seg6",
+		OptionSeg6Mode: "encap",
+		OptionSegs: "fc00::1,fc00::2",
+	}
+	route, err4 := GetNetlinkRoute(&command4)
+	if encap, ok := route.Encap.(*netlink.SEG6Encap); err4 != nil || !ok ||
+		encap.Mode != nl.SEG6_IPTUN_MODE_ENCAP || len(encap.Segments) != 2 {
+		t.Fatalf("Parse error: %v/%v", route, err4)
+	}
+
+	command5 := parser.Command{
+		Operation: parser.ROUTEADD,
+		Network: "fc00::100",
+		NetworkLength: "128",
+		OptionDev: "lo",
+		OptionEncap: "seg6local",
+		OptionAction: "End.DT6",
+		OptionLocalTable: "100",
+	}
+	route, err5 := GetNetlinkRoute(&command5)
+	if encap, ok := route.Encap.(*netlink.SEG6LocalEncap); err5 != nil || !ok ||
+		encap.Action != nl.SEG6_LOCAL_ACTION_END_DT6 || encap.Table != 100 ||
+		!encap.Flags[nl.SEG6_LOCAL_TABLE] || encap.Flags[nl.SEG6_LOCAL_NH6] {
+		t.Fatalf("Parse error: %v/%v", route, err5)
+	}
+
+	command5.OptionAction = "End.X"
+	if route, err := GetNetlinkRoute(&command5); err == nil {
+		t.Fatalf("End.X with table should fail: %v", route)
+	}
+}
+
+func TestGetRouteGetOptions(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.ROUTEGET,
+		OptionTo: "10.1.1.1",
+		OptionFrom: "10.2.2.2",
+		OptionIif: "eth0",
+		OptionFwmark: "0x10",
+	}
+	dst, options, err1 := GetRouteGetOptions(&command1)
+	if (err1 != nil || !dst.Equal(net.ParseIP("10.1.1.1")) ||
+		!options.SrcAddr.Equal(net.ParseIP("10.2.2.2")) || options.Iif != "eth0" || options.Mark != 0x10) {
+		t.Fatalf("Parse error: %v/%v", options, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.ROUTEGET,
+		OptionTo: "fc00::1",
+		OptionFrom: "10.2.2.2",
+	}
+	if _, options, err2 := GetRouteGetOptions(&command2); err2 == nil {
+		t.Fatalf("family mismatch should fail: %v", options)
+	}
+}
+
+func TestGetIPv6RouteOptions(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.ROUTEADD,
+		OptionPref: "high",
+		OptionExpires: "300",
+	}
+	route := netlink.Route{Gw: net.ParseIP("fc00::1")}
+	options, err1 := GetIPv6RouteOptions(&command1, &route)
+	if (err1 != nil || options == nil || options.pref == nil || *options.pref != 1 ||
+		options.expires != 300) {
+		t.Fatalf("Parse error: %v/%v", options, err1)
+	}
+
+	route.Gw = net.ParseIP("10.1.1.1")
+	if options, err := GetIPv6RouteOptions(&command1, &route); err == nil {
+		t.Fatalf("IPv4 route should fail: %v", options)
+	}
+
+	command2 := parser.Command{Operation: parser.ROUTEADD}
+	if options, err := GetIPv6RouteOptions(&command2, &route); err != nil || options != nil {
+		t.Fatalf("Parse error: %v/%v", options, err)
+	}
+}
+
+func TestParseViaTarget(t *testing.T) {
+	peer, dev := parseViaTarget("docker:router@eth1")
+	if (peer == nil || peer.TargetType != parser.DOCKER || peer.Target != "router" || dev != "eth1") {
+		t.Fatalf("Parse error: %v/%s", peer, dev)
+	}
+	peer, dev = parseViaTarget("netns:/var/run/netns/b")
+	if (peer == nil || peer.TargetType != parser.NETNS || peer.Target != "/var/run/netns/b" || dev != "eth0") {
+		t.Fatalf("Parse error: %v/%s", peer, dev)
+	}
+	for _, via := range []string{"10.1.1.1", "fc00::1", "fe80::1"} {
+		if peer, _ := parseViaTarget(via); peer != nil {
+			t.Fatalf("%s is not a peer: %v", via, peer)
+		}
+	}
+
+	command1 := parser.Command{IsDefault: true, OptionVia: "docker:router@eth1"}
+	if family := getViaFamily(&command1, nil); family != netlink.FAMILY_V4 {
+		t.Fatalf("default route via peer should be IPv4: %d", family)
+	}
+	// IPv4 default route via IPv6 address of the peer
+	command1.IsViaInet6 = true
+	if family := getViaFamily(&command1, nil); family != netlink.FAMILY_V6 {
+		t.Fatalf("default route via inet6 peer should take IPv6 address: %d", family)
+	}
+	command1.IsViaInet6 = false
+	_, dst, _ := net.ParseCIDR("2001:db8::/32")
+	if family := getViaFamily(&command1, dst); family != netlink.FAMILY_V6 {
+		t.Fatalf("IPv6 route via peer should be IPv6: %d", family)
+	}
+}
+
+func TestGetNetlinkRule(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.RULEADD,
+		OptionFrom: "10.1.1.0/24",
+		OptionTo: "10.2.0.0/16",
+		OptionFwmark: "0x10/0xff",
+		OptionTable: "100",
+		OptionPriority: "1000",
+	}
+	rule, err1 := GetNetlinkRule(&command1)
+	if (err1 != nil || rule.Src.String() != "10.1.1.0/24" ||
+		rule.Dst.String() != "10.2.0.0/16" || rule.Mark != 0x10 ||
+		*rule.Mask != 0xff || rule.Table != 100 || rule.Priority != 1000) {
+		t.Fatalf("Parse error: %v/%v", rule, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.RULEADD,
+		OptionFrom: "10.1.1.0/24",
+		OptionTo: "2001:db8::/32",
+		OptionTable: "100",
+	}
+	if _, err2 := GetNetlinkRule(&command2); err2 == nil {
+		t.Fatalf("family mismatch is not detected")
+	}
+
+	command3 := parser.Command{
+		Operation: parser.RULEADD,
+		OptionFamily: "inet6",
+		OptionIif: "eth1",
+		OptionTable: "100",
+	}
+	rule, err3 := GetNetlinkRule(&command3)
+	if (err3 != nil || rule.Family != netlink.FAMILY_V6 || rule.IifName != "eth1") {
+		t.Fatalf("Parse error: %v/%v", rule, err3)
+	}
+	command3.OptionFrom = "10.1.1.0/24"
+	if _, err3 = GetNetlinkRule(&command3); err3 == nil {
+		t.Fatalf("family mismatch with inet6 is not detected")
+	}
+}
+
+func TestReadRtTables(t *testing.T) {
+	etcDir, err := ioutil.TempDir("", "koro")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer os.RemoveAll(etcDir)
+	os.MkdirAll(filepath.Join(etcDir, "iproute2/rt_tables.d"), 0755)
+	ioutil.WriteFile(filepath.Join(etcDir, "iproute2/rt_tables"),
+		[]byte("# reserved values\n255\tlocal\n254\tmain\n100 mgmt # management\n"), 0644)
+	ioutil.WriteFile(filepath.Join(etcDir, "iproute2/rt_tables.d/data.conf"),
+		[]byte("0x200\tdata\n"), 0644)
+
+	tables, err := readRtTables(etcDir)
+	if (err != nil || tables["main"] != 254 || tables["mgmt"] != 100 ||
+		tables["data"] != 512) {
+		t.Fatalf("Parse error: %v/%v", tables, err)
+	}
+
+	os.Remove(filepath.Join(etcDir, "iproute2/rt_tables"))
+	tables, err = readRtTables(etcDir)
+	if err != nil || tables["data"] != 512 {
+		t.Fatalf("rt_tables.d without rt_tables is not read: %v/%v", tables, err)
+	}
+	os.RemoveAll(filepath.Join(etcDir, "iproute2/rt_tables.d"))
+	if _, err = readRtTables(etcDir); !os.IsNotExist(err) {
+		t.Fatalf("missing rt_tables is not reported: %v", err)
+	}
+}
+
+func TestGetNetlinkAddr(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.ADDRADD,
+		Network: "2001:db8::1",
+		NetworkLength: "64",
+		OptionDev: "eth0",
+		OptionValidLft: "3600",
+		IsNodad: true,
+		IsNoprefixroute: true,
+	}
+	addr, err1 := GetNetlinkAddr(&command1)
+	if (err1 != nil || addr.ValidLft != 3600 || addr.PreferedLft != 3600 ||
+		addr.Flags != unix.IFA_F_NODAD | unix.IFA_F_NOPREFIXROUTE) {
+		t.Fatalf("Parse error: %v/%v", addr, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.ADDRADD,
+		Network: "10.1.1.1",
+		NetworkLength: "32",
+		OptionDev: "eth0",
+		OptionPeer: "10.1.1.2/24",
+		OptionLabel: "eth0:1",
+		OptionScope: "link",
+	}
+	addr, err2 := GetNetlinkAddr(&command2)
+	if (err2 != nil || addr.Peer.String() != "10.1.1.2/24" ||
+		addr.Label != "eth0:1" || addr.Scope != unix.RT_SCOPE_LINK) {
+		t.Fatalf("Parse error: %v/%v", addr, err2)
+	}
+
+	command2.IsNodad = true
+	if _, err3 := GetNetlinkAddr(&command2); err3 == nil {
+		t.Fatalf("nodad for IPv4 is not detected")
+	}
+
+	command3 := parser.Command{
+		Operation: parser.ADDRADD,
+		Network: "2001:db8::1",
+		NetworkLength: "64",
+		OptionDev: "eth0",
+		OptionValidLft: "forever",
+		OptionPreferredLft: "0",
+	}
+	addr, err4 := GetNetlinkAddr(&command3)
+	if (err4 != nil || uint32(addr.ValidLft) != math.MaxUint32 ||
+		addr.PreferedLft != 0) {
+		t.Fatalf("Parse error: %v/%v", addr, err4)
+	}
+	command3.OptionValidLft = "60"
+	command3.OptionPreferredLft = "forever"
+	if _, err5 := GetNetlinkAddr(&command3); err5 == nil {
+		t.Fatalf("preferred_lft forever over valid_lft is not detected")
+	}
+}
+
+func TestGetNetlinkLink(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.LINKADD,
+		OptionType: "vxlan",
+		OptionDev: "vx0",
+		OptionID: "100",
+		OptionRemote: "192.168.1.2",
+	}
+	link, err1 := GetNetlinkLink(&command1, 2)
+	vxlan, ok := link.(*netlink.Vxlan)
+	if (err1 != nil || !ok || vxlan.VxlanId != 100 || vxlan.VtepDevIndex != 2 ||
+		vxlan.Port != 4789 || vxlan.Group.String() != "192.168.1.2") {
+		t.Fatalf("Parse error: %v/%v", link, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.LINKADD,
+		OptionType: "gre",
+		OptionDev: "gre0",
+		OptionID: "100",
+		OptionRemote: "192.168.1.2",
+	}
+	if _, err2 := GetNetlinkLink(&command2, 0); err2 == nil {
+		t.Fatalf("id for gre is not detected")
+	}
+	command2.OptionID = ""
+	link, err2 := GetNetlinkLink(&command2, 0)
+	if (err2 != nil || link.Type() != "gre" ||
+		link.(*netlink.Gretun).Remote.String() != "192.168.1.2") {
+		t.Fatalf("Parse error: %v/%v", link, err2)
+	}
+	command2.OptionRemote = "2001:db8::2"
+	if link, err2 = GetNetlinkLink(&command2, 0); err2 != nil || link.Type() != "ip6gre" {
+		t.Fatalf("Parse error: %v/%v", link, err2)
+	}
+
+	command3 := parser.Command{
+		Operation: parser.LINKADD,
+		OptionType: "bond",
+		OptionDev: "bond0",
+		OptionMode: "802.3ad",
+		OptionMiimon: "100",
+	}
+	link, err3 := GetNetlinkLink(&command3, 0)
+	bond, ok := link.(*netlink.Bond)
+	if (err3 != nil || !ok || bond.Mode != netlink.BOND_MODE_802_3AD || bond.Miimon != 100) {
+		t.Fatalf("Parse error: %v/%v", link, err3)
+	}
+
+	command4 := parser.Command{
+		Operation: parser.LINKADD,
+		OptionType: "vrf",
+		OptionDev: "mgmt",
+		OptionTable: "10",
+	}
+	link, err4 := GetNetlinkLink(&command4, 0)
+	vrf, ok := link.(*netlink.Vrf)
+	if (err4 != nil || !ok || vrf.Table != 10) {
+		t.Fatalf("Parse error: %v/%v", link, err4)
+	}
+	command4.OptionTable = ""
+	if _, err4 = GetNetlinkLink(&command4, 0); err4 == nil {
+		t.Fatalf("vrf without table is not detected")
+	}
+}
+
+func TestRelabelAddr(t *testing.T) {
+	for label, expected := range map[string]string{
+		"eth1": "net1",
+		"eth1:1": "net1:1",
+		"eth10": "eth10",
+		"": "",
+	} {
+		addr := netlink.Addr{Label: label}
+		if relabelAddr(&addr, "eth1", "net1"); addr.Label != expected {
+			t.Fatalf("label %q is renamed to %q", label, addr.Label)
+		}
+	}
+}
+
+func TestSysctlPath(t *testing.T) {
+	for key, expected := range map[string]string{
+		"net.ipv4.ip_forward": "/proc/sys/net/ipv4/ip_forward",
+		"net.ipv4.conf.eth0/100.rp_filter": "/proc/sys/net/ipv4/conf/eth0.100/rp_filter",
+		"kernel.hostname": "",
+		"net": "",
+		"net.ipv4..ip_forward": "",
+		"net.ipv4./.ip_forward": "",
+	} {
+		path, err := sysctlPath(key)
+		if (expected == "" && err == nil) || (expected != "" && path != expected) {
+			t.Fatalf("%q is converted to %q/%v", key, path, err)
+		}
+	}
+}
+
+func TestGetNetlinkNeigh(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.NEIGHADD,
+		OptionNeighbor: "10.1.1.1",
+		OptionLladdr: "02:00:00:00:00:01",
+	}
+	neigh, err1 := GetNetlinkNeigh(&command1, 2)
+	if (err1 != nil || neigh.LinkIndex != 2 || neigh.Family != netlink.FAMILY_V4 ||
+		neigh.State != netlink.NUD_PERMANENT ||
+		neigh.HardwareAddr.String() != "02:00:00:00:00:01") {
+		t.Fatalf("Parse error: %v/%v", neigh, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.NEIGHADD,
+		OptionNeighbor: "10.1.1.1",
+	}
+	if _, err2 := GetNetlinkNeigh(&command2, 2); err2 == nil {
+		t.Fatalf("permanent neighbor without lladdr is not detected")
+	}
+
+	command3 := parser.Command{
+		Operation: parser.NEIGHADD,
+		OptionNeighbor: "2001:db8::1",
+		IsProxy: true,
+	}
+	neigh, err3 := GetNetlinkNeigh(&command3, 2)
+	if (err3 != nil || neigh.Family != netlink.FAMILY_V6 || neigh.Flags != netlink.NTF_PROXY) {
+		t.Fatalf("Parse error: %v/%v", neigh, err3)
+	}
+}
+
+func TestGetNetlinkQdisc(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.QDISCADD,
+		OptionType: "netem",
+		OptionDelay: "100ms",
+		OptionJitter: "10ms",
+		OptionLoss: "100%",
+	}
+	qdisc, err1 := GetNetlinkQdisc(&command1, 2)
+	netem, ok := qdisc.(*netlink.Netem)
+	if (err1 != nil || !ok || netem.LinkIndex != 2 || netem.Parent != netlink.HANDLE_ROOT ||
+		netem.Latency == 0 || netem.Jitter == 0 || netem.Loss != math.MaxUint32) {
+		t.Fatalf("Parse error: %v/%v", qdisc, err1)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.QDISCADD,
+		OptionType: "htb",
+		OptionHandle: "1:",
+		OptionDefault: "10",
+	}
+	qdisc, err2 := GetNetlinkQdisc(&command2, 2)
+	htb, ok := qdisc.(*netlink.Htb)
+	if (err2 != nil || !ok || htb.Handle != netlink.MakeHandle(1, 0) || htb.Defcls != 0x10) {
+		t.Fatalf("Parse error: %v/%v", qdisc, err2)
+	}
+
+	command3 := parser.Command{
+		Operation: parser.QDISCADD,
+		OptionType: "tbf",
+		OptionRate: "1mbit",
+	}
+	if _, err3 := GetNetlinkQdisc(&command3, 2); err3 == nil {
+		t.Fatalf("tbf without burst and latency is not detected")
+	}
+
+	command4 := parser.Command{
+		Operation: parser.CLASSADD,
+		OptionType: "htb",
+		OptionParent: "1:",
+		OptionClassid: "1:10",
+		OptionRate: "8kbit",
+	}
+	class, err4 := GetNetlinkClass(&command4, 2)
+	htbClass, ok := class.(*netlink.HtbClass)
+	if (err4 != nil || !ok || htbClass.Handle != netlink.MakeHandle(1, 0x10) ||
+		htbClass.Rate != 1000 || htbClass.Ceil != 1000) {
+		t.Fatalf("Parse error: %v/%v", class, err4)
+	}
+}
+
+func TestGetNftRule(t *testing.T) {
+	command1 := parser.Command{
+		Operation: parser.NATMASQUERADE,
+		OptionSrc: "10.1.1.0/24",
+		OptionOif: "eth0",
+	}
+	chain, exprs, err1 := GetNftRule(&command1)
+	if err1 != nil || chain != "postrouting" || len(exprs) == 0 {
+		t.Fatalf("Parse error: %s/%v/%v", chain, exprs, err1)
+	}
+	if _, ok := exprs[len(exprs)-1].(*expr.Masq); !ok {
+		t.Fatalf("masquerade is not the last expression: %v", exprs)
+	}
+
+	command2 := parser.Command{
+		Operation: parser.NATDNAT,
+		OptionDst: "10.0.0.1",
+		OptionProto: "tcp",
+		OptionDport: "80",
+		OptionTo: "10.1.1.2:8080",
+	}
+	chain, exprs, err2 := GetNftRule(&command2)
+	nat, ok := exprs[len(exprs)-1].(*expr.NAT)
+	if (err2 != nil || chain != "prerouting" || !ok || nat.Type != expr.NATTypeDestNAT ||
+		nat.Family != unix.NFPROTO_IPV4 || nat.RegProtoMin != 2) {
+		t.Fatalf("Parse error: %s/%v/%v", chain, exprs, err2)
+	}
+
+	command3 := parser.Command{
+		Operation: parser.NATSNAT,
+		OptionSrc: "10.1.1.0/24",
+		OptionTo: "2001:db8::1",
+	}
+	if _, _, err3 := GetNftRule(&command3); err3 == nil {
+		t.Fatalf("address family mismatch is not detected")
+	}
+
+	command4 := parser.Command{
+		Operation: parser.FILTERADD,
+		OptionChain: "input",
+		OptionVerdict: "drop",
+		OptionDport: "22",
+	}
+	if _, _, err4 := GetNftRule(&command4); err4 == nil {
+		t.Fatalf("dport without proto is not detected")
+	}
+}
+
+func TestGetConntrackFilter(t *testing.T) {
+	flow := &netlink.ConntrackFlow{}
+	flow.Forward.Protocol = unix.IPPROTO_UDP
+	flow.Forward.SrcIP = net.ParseIP("10.1.1.1")
+	flow.Forward.DstIP = net.ParseIP("10.2.2.2")
+
+	command1 := parser.Command{}
+	filter, families, err1 := GetConntrackFilter(&command1)
+	if err1 != nil || len(families) != 2 || !filter.MatchConntrackFlow(flow) {
+		t.Fatalf("empty filter does not match all: %v/%v", families, err1)
+	}
+
+	command2 := parser.Command{
+		OptionSrc: "10.1.1.0/24",
+		OptionProto: "tcp",
+	}
+	filter, families, err2 := GetConntrackFilter(&command2)
+	if err2 != nil || len(families) != 1 || families[0] != unix.AF_INET || filter.MatchConntrackFlow(flow) {
+		t.Fatalf("proto is not matched: %v/%v", families, err2)
+	}
+
+	command3 := parser.Command{
+		OptionSrc: "10.1.1.0/24",
+		OptionDst: "2001:db8::/64",
+	}
+	if _, _, err3 := GetConntrackFilter(&command3); err3 == nil {
+		t.Fatalf("address family mismatch is not detected")
+	}
+}
+
+func TestIsExistingGone(t *testing.T) {
+	command1 := parser.Command{IgnoreExisting: true, IgnoreMissing: true}
+	wrapped := fmt.Errorf("failed to add: %w", unix.EEXIST)
+	if !isExisting(&command1, wrapped) || !isExisting(&command1, unix.EEXIST) {
+		t.Fatalf("wrapped EEXIST is not detected: %v", wrapped)
+	}
+	wrapped = fmt.Errorf("failed to delete: %w", unix.ESRCH)
+	if !isGone(wrapped) || !isMissing(&command1, wrapped) || isGone(unix.EPERM) {
+		t.Fatalf("wrapped ESRCH is not detected: %v", wrapped)
+	}
+	command1.IgnoreExisting = false
+	if isExisting(&command1, unix.EEXIST) {
+		t.Fatalf("EEXIST without --ignore-existing is detected")
+	}
 }
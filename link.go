@@ -0,0 +1,678 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	koko_api "github.com/redhat-nfvpe/koko/api"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// getLinkNumber parses numeric attribute of link, such as mtu
+func getLinkNumber(name, val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %q", name, val)
+	}
+	return n, nil
+}
+
+// SetLink changes link attributes with netlink API. Link is brought down
+// first and up at last, so that 'name NEW up' can rename running link.
+func SetLink(command *parser.Command) (err error) {
+	var mtu, txqlen int
+	var hwaddr net.HardwareAddr
+
+	if command.OptionMtu != "" {
+		if mtu, err = getLinkNumber("mtu", command.OptionMtu); err != nil {
+			return err
+		}
+	}
+	if command.OptionTxqueuelen != "" {
+		if txqlen, err = getLinkNumber("txqueuelen", command.OptionTxqueuelen); err != nil {
+			return err
+		}
+	}
+	if command.OptionLladdr != "" {
+		if hwaddr, err = net.ParseMAC(command.OptionLladdr); err != nil {
+			return fmt.Errorf("invalid address %q", command.OptionLladdr)
+		}
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		link, err1 := netlink.LinkByName(command.OptionDev)
+		if err1 != nil {
+			return fmt.Errorf("failed to find link %q: %v", command.OptionDev, err1)
+		}
+
+		if command.OptionState == "down" ||
+			(command.OptionState == "up" && command.OptionName != "") {
+			if err1 = netlink.LinkSetDown(link); err1 != nil {
+				return fmt.Errorf("failed to set %q down: %v", command.OptionDev, err1)
+			}
+		}
+		if command.OptionMtu != "" {
+			if err1 = netlink.LinkSetMTU(link, mtu); err1 != nil {
+				return fmt.Errorf("failed to set mtu of %q: %v", command.OptionDev, err1)
+			}
+		}
+		if hwaddr != nil {
+			if err1 = netlink.LinkSetHardwareAddr(link, hwaddr); err1 != nil {
+				return fmt.Errorf("failed to set address of %q: %v", command.OptionDev, err1)
+			}
+		}
+		if command.OptionTxqueuelen != "" {
+			if err1 = netlink.LinkSetTxQLen(link, txqlen); err1 != nil {
+				return fmt.Errorf("failed to set txqueuelen of %q: %v", command.OptionDev, err1)
+			}
+		}
+		if command.OptionAlias != "" {
+			if err1 = netlink.LinkSetAlias(link, command.OptionAlias); err1 != nil {
+				return fmt.Errorf("failed to set alias of %q: %v", command.OptionDev, err1)
+			}
+		}
+		if command.OptionMaster != "" {
+			master, err1 := netlink.LinkByName(command.OptionMaster)
+			if err1 != nil {
+				return fmt.Errorf("failed to find master %q: %v", command.OptionMaster, err1)
+			}
+			if err1 = netlink.LinkSetMaster(link, master); err1 != nil {
+				return fmt.Errorf("failed to set master of %q to %q: %v",
+					command.OptionDev, command.OptionMaster, err1)
+			}
+		}
+		if command.IsNomaster {
+			if err1 = netlink.LinkSetNoMaster(link); err1 != nil {
+				return fmt.Errorf("failed to release %q from its master: %v",
+					command.OptionDev, err1)
+			}
+		}
+		if command.OptionName != "" {
+			if err1 = netlink.LinkSetName(link, command.OptionName); err1 != nil {
+				return fmt.Errorf("failed to rename %q to %q: %v",
+					command.OptionDev, command.OptionName, err1)
+			}
+		}
+		if command.OptionState == "up" {
+			if err1 = netlink.LinkSetUp(link); err1 != nil {
+				return fmt.Errorf("failed to set %q up: %v", command.OptionDev, err1)
+			}
+		}
+		return nil
+	})
+}
+
+// macvlanModes are macvlan mode names in CLI
+var macvlanModes = map[string]netlink.MacvlanMode{
+	"private":  netlink.MACVLAN_MODE_PRIVATE,
+	"vepa":     netlink.MACVLAN_MODE_VEPA,
+	"bridge":   netlink.MACVLAN_MODE_BRIDGE,
+	"passthru": netlink.MACVLAN_MODE_PASSTHRU,
+	"source":   netlink.MACVLAN_MODE_SOURCE,
+}
+
+// ipvlanModes are ipvlan mode names in CLI
+var ipvlanModes = map[string]netlink.IPVlanMode{
+	"l2":  netlink.IPVLAN_MODE_L2,
+	"l3":  netlink.IPVLAN_MODE_L3,
+	"l3s": netlink.IPVLAN_MODE_L3S,
+}
+
+// linkAddOptions are options of 'link add' which each link type supports,
+// other than link/dev, name and up
+var linkAddOptions = map[string][]string{
+	"vlan":    {"id"},
+	"macvlan": {"mode"},
+	"ipvlan":  {"mode"},
+	"vxlan":   {"id", "local", "remote", "dstport"},
+	"gre":     {"local", "remote"},
+	"ipip":    {"local", "remote"},
+	"ip6tnl":  {"local", "remote"},
+	"bridge":  {"stp", "vlan_filtering"},
+	"bond":    {"mode", "miimon"},
+	"vrf":     {"table"},
+}
+
+// checkLinkAddOptions checks whether the link type supports given options
+func checkLinkAddOptions(command *parser.Command) error {
+	supported, ok := linkAddOptions[command.OptionType]
+	if !ok {
+		return fmt.Errorf("unsupported link type %q", command.OptionType)
+	}
+	given := map[string]string{
+		"id":             command.OptionID,
+		"mode":           command.OptionMode,
+		"local":          command.OptionLocal,
+		"remote":         command.OptionRemote,
+		"dstport":        command.OptionDstport,
+		"stp":            command.OptionStp,
+		"vlan_filtering": command.OptionVlanFiltering,
+		"miimon":         command.OptionMiimon,
+		"table":          command.OptionTable,
+	}
+	for _, name := range []string{"id", "mode", "local", "remote", "dstport",
+		"stp", "vlan_filtering", "miimon", "table"} {
+		if given[name] == "" {
+			continue
+		}
+		found := false
+		for _, option := range supported {
+			found = found || option == name
+		}
+		if !found {
+			return fmt.Errorf("%s does not support %s", command.OptionType, name)
+		}
+	}
+	return nil
+}
+
+// getSwitch parses on/off option
+func getSwitch(name, val string) (bool, error) {
+	switch val {
+	case "on", "1":
+		return true, nil
+	case "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid %s %q", name, val)
+}
+
+// setBridgeStp turns on/off STP of the bridge. netlink.Bridge does not have
+// the attribute, so IFLA_BR_STP_STATE is sent here.
+func setBridgeStp(link netlink.Link, stp bool) error {
+	state := uint32(0)
+	if stp {
+		state = 1
+	}
+	req := nl.NewNetlinkRequest(unix.RTM_NEWLINK, unix.NLM_F_ACK)
+	msg := nl.NewIfInfomsg(unix.AF_UNSPEC)
+	msg.Index = int32(link.Attrs().Index)
+	req.AddData(msg)
+	linkInfo := nl.NewRtAttr(unix.IFLA_LINKINFO, nil)
+	linkInfo.AddRtAttr(nl.IFLA_INFO_KIND, nl.NonZeroTerminated("bridge"))
+	data := linkInfo.AddRtAttr(nl.IFLA_INFO_DATA, nil)
+	data.AddRtAttr(nl.IFLA_BR_STP_STATE, nl.Uint32Attr(state))
+	req.AddData(linkInfo)
+	_, err := req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
+
+// getTunnelEndpoints parses local/remote address of tunnel
+func getTunnelEndpoints(command *parser.Command) (local, remote net.IP, err error) {
+	if command.OptionRemote == "" {
+		return nil, nil, fmt.Errorf("%s requires remote", command.OptionType)
+	}
+	if remote = net.ParseIP(command.OptionRemote); remote == nil {
+		return nil, nil, fmt.Errorf("invalid remote %q", command.OptionRemote)
+	}
+	if command.OptionLocal != "" {
+		if local = net.ParseIP(command.OptionLocal); local == nil {
+			return nil, nil, fmt.Errorf("invalid local %q", command.OptionLocal)
+		}
+		if (local.To4() == nil) != (remote.To4() == nil) {
+			return nil, nil, fmt.Errorf("address family mismatch between local and remote")
+		}
+	}
+	return local, remote, nil
+}
+
+// GetNetlinkLink converts from CLI argument to netlink.Link to be created.
+// parentIndex is the index of the link given by link/dev option, which is
+// the parent of sub-interface or the underlay of tunnel.
+func GetNetlinkLink(command *parser.Command, parentIndex int) (link netlink.Link, err error) {
+	if err = checkLinkAddOptions(command); err != nil {
+		return nil, err
+	}
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = command.OptionDev
+
+	switch command.OptionType {
+	case "vlan":
+		if command.OptionID == "" {
+			return nil, fmt.Errorf("vlan requires id")
+		}
+		id, err := strconv.Atoi(command.OptionID)
+		if err != nil || id < 0 || id > 4094 {
+			return nil, fmt.Errorf("invalid vlan id %q", command.OptionID)
+		}
+		attrs.ParentIndex = parentIndex
+		link = &netlink.Vlan{LinkAttrs: attrs, VlanId: id}
+	case "macvlan":
+		mode := netlink.MACVLAN_MODE_DEFAULT
+		if command.OptionMode != "" {
+			var ok bool
+			if mode, ok = macvlanModes[command.OptionMode]; !ok {
+				return nil, fmt.Errorf("invalid macvlan mode %q", command.OptionMode)
+			}
+		}
+		attrs.ParentIndex = parentIndex
+		link = &netlink.Macvlan{LinkAttrs: attrs, Mode: mode}
+	case "ipvlan":
+		mode := netlink.IPVLAN_MODE_L2
+		if command.OptionMode != "" {
+			var ok bool
+			if mode, ok = ipvlanModes[command.OptionMode]; !ok {
+				return nil, fmt.Errorf("invalid ipvlan mode %q", command.OptionMode)
+			}
+		}
+		attrs.ParentIndex = parentIndex
+		link = &netlink.IPVlan{LinkAttrs: attrs, Mode: mode}
+	case "vxlan":
+		if command.OptionID == "" {
+			return nil, fmt.Errorf("vxlan requires id")
+		}
+		vni, err := strconv.Atoi(command.OptionID)
+		if err != nil || vni < 0 || vni >= 1<<24 {
+			return nil, fmt.Errorf("invalid vxlan id %q", command.OptionID)
+		}
+		local, remote, err := getTunnelEndpoints(command)
+		if err != nil {
+			return nil, err
+		}
+		// IANA assigned port, instead of linux default 8472
+		port := 4789
+		if command.OptionDstport != "" {
+			port, err = strconv.Atoi(command.OptionDstport)
+			if err != nil || port <= 0 || port > 65535 {
+				return nil, fmt.Errorf("invalid dstport %q", command.OptionDstport)
+			}
+		}
+		link = &netlink.Vxlan{LinkAttrs: attrs, VxlanId: vni, VtepDevIndex: parentIndex,
+			SrcAddr: local, Group: remote, Port: port, Learning: true}
+	case "gre":
+		local, remote, err := getTunnelEndpoints(command)
+		if err != nil {
+			return nil, err
+		}
+		// netlink takes gre as ip6gre without IPv4 local, so bind IPv4
+		// remote to any address as iproute2 does
+		if local == nil && remote.To4() != nil {
+			local = net.IPv4zero
+		}
+		link = &netlink.Gretun{LinkAttrs: attrs, Link: uint32(parentIndex),
+			Local: local, Remote: remote}
+	case "ipip":
+		local, remote, err := getTunnelEndpoints(command)
+		if err != nil {
+			return nil, err
+		}
+		if remote.To4() == nil {
+			return nil, fmt.Errorf("ipip supports only IPv4 endpoints")
+		}
+		link = &netlink.Iptun{LinkAttrs: attrs, Link: uint32(parentIndex),
+			Local: local, Remote: remote}
+	case "ip6tnl":
+		local, remote, err := getTunnelEndpoints(command)
+		if err != nil {
+			return nil, err
+		}
+		if remote.To4() != nil {
+			return nil, fmt.Errorf("ip6tnl supports only IPv6 endpoints")
+		}
+		link = &netlink.Ip6tnl{LinkAttrs: attrs, Link: uint32(parentIndex),
+			Local: local, Remote: remote}
+	case "bridge":
+		bridge := &netlink.Bridge{LinkAttrs: attrs}
+		if command.OptionStp != "" {
+			if _, err = getSwitch("stp", command.OptionStp); err != nil {
+				return nil, err
+			}
+		}
+		if command.OptionVlanFiltering != "" {
+			vlanFiltering, err := getSwitch("vlan_filtering", command.OptionVlanFiltering)
+			if err != nil {
+				return nil, err
+			}
+			bridge.VlanFiltering = &vlanFiltering
+		}
+		link = bridge
+	case "bond":
+		bond := netlink.NewLinkBond(attrs)
+		if command.OptionMode != "" {
+			if bond.Mode = netlink.StringToBondMode(command.OptionMode); bond.Mode == netlink.BOND_MODE_UNKNOWN {
+				return nil, fmt.Errorf("invalid bond mode %q", command.OptionMode)
+			}
+		}
+		if command.OptionMiimon != "" {
+			if bond.Miimon, err = getLinkNumber("miimon", command.OptionMiimon); err != nil {
+				return nil, err
+			}
+		}
+		link = bond
+	case "vrf":
+		if command.OptionTable == "" {
+			return nil, fmt.Errorf("vrf requires table")
+		}
+		table, err := getTableID(command, command.OptionTable)
+		if err != nil {
+			return nil, err
+		}
+		link = &netlink.Vrf{LinkAttrs: attrs, Table: uint32(table)}
+	}
+	return link, nil
+}
+
+// AddLink creates link in the target namespace. Sub-interface (vlan,
+// macvlan or ipvlan) is created on its parent in the current namespace,
+// then moved into the target namespace. Others, such as tunnels, are
+// created in the target namespace. Then the link is renamed and brought up
+// there, if given.
+func AddLink(command *parser.Command) (err error) {
+	if err = checkLinkAddOptions(command); err != nil {
+		return err
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	// sub-interface is moved into the target namespace after LinkAdd, so
+	// check the existing link there first
+	name := command.OptionDev
+	if command.OptionName != "" {
+		name = command.OptionName
+	}
+	err = targetNS.Do(func(_ ns.NetNS) error {
+		if _, err1 := netlink.LinkByName(name); err1 == nil {
+			return syscall.EEXIST
+		}
+		return nil
+	})
+	if err != nil {
+		if isExisting(command, err) {
+			return errUnchanged
+		}
+		return fmt.Errorf("failed to create %s %q in %s: %v",
+			command.OptionType, name, targetNS.Path(), err)
+	}
+
+	switch command.OptionType {
+	case "vlan", "macvlan", "ipvlan":
+		if err = addSubInterface(command, targetNS); err != nil {
+			return err
+		}
+	default:
+		err = targetNS.Do(func(_ ns.NetNS) error {
+			parentIndex := 0
+			if command.OptionParent != "" {
+				parent, err1 := netlink.LinkByName(command.OptionParent)
+				if err1 != nil {
+					return fmt.Errorf("failed to find link %q in %s: %v",
+						command.OptionParent, targetNS.Path(), err1)
+				}
+				parentIndex = parent.Attrs().Index
+			}
+			link, err1 := GetNetlinkLink(command, parentIndex)
+			if err1 != nil {
+				return err1
+			}
+			if err1 = netlink.LinkAdd(link); err1 != nil {
+				if isExisting(command, err1) {
+					return errUnchanged
+				}
+				return fmt.Errorf("failed to create %s %q in %s: %v",
+					command.OptionType, command.OptionDev, targetNS.Path(), err1)
+			}
+			if command.OptionStp != "" {
+				stp, _ := getSwitch("stp", command.OptionStp)
+				if err1 = setBridgeStp(link, stp); err1 != nil {
+					return fmt.Errorf("failed to set stp of %q: %v", command.OptionDev, err1)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		link, err1 := netlink.LinkByName(command.OptionDev)
+		if err1 != nil {
+			return fmt.Errorf("failed to find %q in %s: %v",
+				command.OptionDev, targetNS.Path(), err1)
+		}
+		if command.OptionName != "" {
+			if err1 = netlink.LinkSetName(link, command.OptionName); err1 != nil {
+				return fmt.Errorf("failed to rename %q to %q in %s: %v",
+					command.OptionDev, command.OptionName, targetNS.Path(), err1)
+			}
+		}
+		if command.OptionState == "up" {
+			if err1 = netlink.LinkSetUp(link); err1 != nil {
+				return fmt.Errorf("failed to set %q up in %s: %v",
+					link.Attrs().Name, targetNS.Path(), err1)
+			}
+		}
+		return nil
+	})
+}
+
+// addSubInterface creates sub-interface on its parent in the current
+// namespace and moves it into targetNS
+func addSubInterface(command *parser.Command, targetNS ns.NetNS) (err error) {
+	if command.OptionParent == "" {
+		return fmt.Errorf("%s requires link", command.OptionType)
+	}
+	parent, err := netlink.LinkByName(command.OptionParent)
+	if err != nil {
+		return fmt.Errorf("failed to find parent link %q: %v", command.OptionParent, err)
+	}
+	link, err := GetNetlinkLink(command, parent.Attrs().Index)
+	if err != nil {
+		return err
+	}
+
+	if err = netlink.LinkAdd(link); err != nil {
+		return fmt.Errorf("failed to create %s %q on %q: %v",
+			command.OptionType, command.OptionDev, command.OptionParent, err)
+	}
+	if command.TargetType != parser.NSNONE {
+		if err = netlink.LinkSetNsFd(link, int(targetNS.Fd())); err != nil {
+			netlink.LinkDel(link)
+			return fmt.Errorf("failed to move %q into %s: %v",
+				command.OptionDev, targetNS.Path(), err)
+		}
+	}
+	return nil
+}
+
+// getVeth converts given end of veth in CLI into koko's VEth
+func getVeth(end parser.VethEnd) (veth koko_api.VEth, err error) {
+	veth.LinkName = end.Name
+	veth.NsName, err = getNamepace(&parser.Command{TargetType: end.TargetType, Target: end.Target})
+	if err != nil {
+		return veth, fmt.Errorf("failed to find namespace of %q: %v", end.Name, err)
+	}
+	if end.Address != "" {
+		ip, ipnet, err1 := net.ParseCIDR(end.Address)
+		if err1 != nil {
+			return veth, fmt.Errorf("invalid address %q of %q", end.Address, end.Name)
+		}
+		veth.IPAddr = []net.IPNet{{IP: ip, Mask: ipnet.Mask}}
+	}
+	return veth, nil
+}
+
+// AddVeth creates veth pair and puts each end into its namespace with
+// given address, then brings them up
+func AddVeth(command *parser.Command) (err error) {
+	veth1, err := getVeth(command.Veth[0])
+	if err != nil {
+		return err
+	}
+	veth2, err := getVeth(command.Veth[1])
+	if err != nil {
+		return err
+	}
+	return koko_api.MakeVeth(veth1, veth2)
+}
+
+// formatLink formats link as 'ip link show' does
+func formatLink(link netlink.Link) string {
+	attrs := link.Attrs()
+	var b strings.Builder
+
+	flags := []string{}
+	for _, flag := range strings.Split(attrs.Flags.String(), "|") {
+		if flag != "0" {
+			flags = append(flags, strings.ToUpper(flag))
+		}
+	}
+	fmt.Fprintf(&b, "%d: %s: <%s> mtu %d", attrs.Index, attrs.Name,
+		strings.Join(flags, ","), attrs.MTU)
+	if attrs.MasterIndex != 0 {
+		if master, err := netlink.LinkByIndex(attrs.MasterIndex); err == nil {
+			fmt.Fprintf(&b, " master %s", master.Attrs().Name)
+		}
+	}
+	fmt.Fprintf(&b, " state %s qlen %d\n", strings.ToUpper(attrs.OperState.String()), attrs.TxQLen)
+	fmt.Fprintf(&b, "    link/%s %s", link.Type(), attrs.HardwareAddr)
+	if attrs.Alias != "" {
+		fmt.Fprintf(&b, "\n    alias %s", attrs.Alias)
+	}
+	return b.String()
+}
+
+// ShowLink shows links in the namespace
+func ShowLink(command *parser.Command) (err error) {
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		if command.OptionDev != "" {
+			link, err1 := netlink.LinkByName(command.OptionDev)
+			if err1 != nil {
+				return fmt.Errorf("failed to find link %q: %v", command.OptionDev, err1)
+			}
+			fmt.Println(formatLink(link))
+			return nil
+		}
+		links, err1 := netlink.LinkList()
+		if err1 != nil {
+			return err1
+		}
+		for _, link := range links {
+			fmt.Println(formatLink(link))
+		}
+		return nil
+	})
+}
+
+// relabelAddr renames the label of IPv4 address which follows the old link
+// name, because the kernel requires the label to start with the link name
+func relabelAddr(addr *netlink.Addr, oldName, newName string) {
+	if addr.Label == oldName {
+		addr.Label = newName
+	} else if strings.HasPrefix(addr.Label, oldName+":") {
+		addr.Label = newName + addr.Label[len(oldName):]
+	}
+}
+
+// moveLink moves the link from srcNS to dstNS. Addresses (except IPv6
+// link-local ones which the kernel generates) and up state are restored in
+// dstNS if keepaddr/keepstate are given.
+func moveLink(command *parser.Command, srcNS, dstNS ns.NetNS) error {
+	var addrs []netlink.Addr
+	var wasUp bool
+
+	err := srcNS.Do(func(_ ns.NetNS) error {
+		link, err1 := netlink.LinkByName(command.OptionDev)
+		if err1 != nil {
+			return fmt.Errorf("failed to find link %q in %s: %v",
+				command.OptionDev, srcNS.Path(), err1)
+		}
+		wasUp = link.Attrs().Flags&net.FlagUp != 0
+		if command.IsKeepaddr {
+			list, err1 := netlink.AddrList(link, netlink.FAMILY_ALL)
+			if err1 != nil {
+				return err1
+			}
+			for _, addr := range list {
+				if addr.IP.To4() == nil && addr.IP.IsLinkLocalUnicast() {
+					continue
+				}
+				addrs = append(addrs, addr)
+			}
+		}
+		if err1 = netlink.LinkSetNsFd(link, int(dstNS.Fd())); err1 != nil {
+			return fmt.Errorf("failed to move %q into %s: %v",
+				command.OptionDev, dstNS.Path(), err1)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return dstNS.Do(func(_ ns.NetNS) error {
+		link, err1 := netlink.LinkByName(command.OptionDev)
+		if err1 != nil {
+			return fmt.Errorf("failed to find link %q in %s: %v",
+				command.OptionDev, dstNS.Path(), err1)
+		}
+		name := command.OptionDev
+		if command.OptionName != "" {
+			if err1 = netlink.LinkSetName(link, command.OptionName); err1 != nil {
+				return fmt.Errorf("failed to rename %q to %q: %v",
+					command.OptionDev, command.OptionName, err1)
+			}
+			name = command.OptionName
+		}
+		for _, addr := range addrs {
+			relabelAddr(&addr, command.OptionDev, name)
+			if err1 = netlink.AddrAdd(link, &addr); err1 != nil {
+				return fmt.Errorf("failed to add address %s to %q: %v",
+					addr.IPNet, name, err1)
+			}
+		}
+		if command.IsKeepstate && wasUp {
+			if err1 = netlink.LinkSetUp(link); err1 != nil {
+				return fmt.Errorf("failed to bring %q up: %v", name, err1)
+			}
+		}
+		return nil
+	})
+}
+
+// AdoptReleaseLink moves the link from the current namespace into the target
+// one (adopt) or back from the target namespace (release)
+func AdoptReleaseLink(command *parser.Command) (err error) {
+	if command.TargetType == parser.NSNONE {
+		return fmt.Errorf("link adopt/release requires target namespace")
+	}
+
+	hostNS, err := ns.GetCurrentNS()
+	if err != nil {
+		return err
+	}
+	defer hostNS.Close()
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	if command.Operation == parser.LINKADOPT {
+		return moveLink(command, hostNS, targetNS)
+	}
+	return moveLink(command, targetNS, hostNS)
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+)
+
+// neighStates are neighbor states which iproute2 knows as 'nud'
+var neighStates = map[string]int{
+	"permanent":  netlink.NUD_PERMANENT,
+	"noarp":      netlink.NUD_NOARP,
+	"reachable":  netlink.NUD_REACHABLE,
+	"stale":      netlink.NUD_STALE,
+	"none":       netlink.NUD_NONE,
+	"incomplete": netlink.NUD_INCOMPLETE,
+	"delay":      netlink.NUD_DELAY,
+	"probe":      netlink.NUD_PROBE,
+	"failed":     netlink.NUD_FAILED,
+}
+
+// getNeighState converts nud given in CLI into neighbor state
+func getNeighState(nud string) (int, error) {
+	state, ok := neighStates[nud]
+	if !ok {
+		return 0, fmt.Errorf("invalid nud %q", nud)
+	}
+	return state, nil
+}
+
+// GetNetlinkNeigh converts from CLI argument to netlink.Neigh structure.
+// linkIndex is the index of 'dev' in the target namespace.
+func GetNetlinkNeigh(command *parser.Command, linkIndex int) (neigh *netlink.Neigh, err error) {
+	ip := net.ParseIP(command.OptionNeighbor)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid neighbor address %q", command.OptionNeighbor)
+	}
+	neigh = &netlink.Neigh{
+		LinkIndex: linkIndex,
+		Family:    ipFamily(ip),
+		IP:        ip,
+	}
+
+	if command.IsProxy {
+		if command.OptionLladdr != "" || command.OptionNud != "" {
+			return nil, fmt.Errorf("proxy does not support lladdr and nud")
+		}
+		neigh.Flags = netlink.NTF_PROXY
+		return neigh, nil
+	}
+
+	neigh.State = netlink.NUD_PERMANENT
+	if command.OptionNud != "" {
+		if neigh.State, err = getNeighState(command.OptionNud); err != nil {
+			return nil, err
+		}
+	}
+	if command.OptionLladdr != "" {
+		if neigh.HardwareAddr, err = net.ParseMAC(command.OptionLladdr); err != nil {
+			return nil, fmt.Errorf("invalid lladdr %q", command.OptionLladdr)
+		}
+	} else if command.Operation != parser.NEIGHDEL && neigh.State&(netlink.NUD_PERMANENT|netlink.NUD_NOARP) != 0 {
+		return nil, fmt.Errorf("nud %s requires lladdr", command.OptionNud)
+	}
+	return neigh, nil
+}
+
+// formatNeigh formats neighbor as 'ip neighbor show' does
+func formatNeigh(neigh netlink.Neigh) string {
+	var b strings.Builder
+
+	b.WriteString(neigh.IP.String())
+	if link, err := netlink.LinkByIndex(neigh.LinkIndex); err == nil {
+		fmt.Fprintf(&b, " dev %s", link.Attrs().Name)
+	}
+	if neigh.Flags&netlink.NTF_PROXY != 0 {
+		b.WriteString(" proxy")
+		return b.String()
+	}
+	if neigh.HardwareAddr != nil {
+		fmt.Fprintf(&b, " lladdr %s", neigh.HardwareAddr)
+	}
+	for name, state := range neighStates {
+		if state != netlink.NUD_NONE && neigh.State&state != 0 {
+			fmt.Fprintf(&b, " %s", strings.ToUpper(name))
+		}
+	}
+	return b.String()
+}
+
+// getNeighLinkIndex returns the index of 'dev' in the target namespace, or 0
+// if it is not given
+func getNeighLinkIndex(command *parser.Command) (int, error) {
+	if command.OptionDev == "" {
+		return 0, nil
+	}
+	link, err := netlink.LinkByName(command.OptionDev)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find link %q: %v", command.OptionDev, err)
+	}
+	return link.Attrs().Index, nil
+}
+
+// AddDelNeigh adds/deletes/replaces neighbor with netlink API
+func AddDelNeigh(command *parser.Command) (err error) {
+	if command.OptionDev == "" {
+		return fmt.Errorf("neighbor requires dev")
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		linkIndex, err1 := getNeighLinkIndex(command)
+		if err1 != nil {
+			return err1
+		}
+		neigh, err1 := GetNetlinkNeigh(command, linkIndex)
+		if err1 != nil {
+			return err1
+		}
+		switch command.Operation {
+		case parser.NEIGHADD:
+			if err1 = netlink.NeighAdd(neigh); err1 != nil {
+				if isExisting(command, err1) {
+					return errUnchanged
+				}
+				return fmt.Errorf("failed to add neighbor %s: %v", neigh.IP, err1)
+			}
+		case parser.NEIGHDEL:
+			if err1 = netlink.NeighDel(neigh); err1 != nil {
+				if isMissing(command, err1) {
+					return errUnchanged
+				}
+				return fmt.Errorf("failed to delete neighbor %s: %v", neigh.IP, err1)
+			}
+		case parser.NEIGHREPLACE:
+			if err1 = netlink.NeighSet(neigh); err1 != nil {
+				return fmt.Errorf("failed to replace neighbor %s: %v", neigh.IP, err1)
+			}
+		}
+		return nil
+	})
+}
+
+// listNeighs returns neighbors which match with given dev/nud/proxy
+func listNeighs(command *parser.Command, linkIndex int) ([]netlink.Neigh, error) {
+	// FAMILY_ALL includes fdb entries of bridge ports, which have no IP
+	var neighs []netlink.Neigh
+	for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+		var list []netlink.Neigh
+		var err error
+		if command.IsProxy {
+			list, err = netlink.NeighProxyList(linkIndex, family)
+		} else {
+			list, err = netlink.NeighList(linkIndex, family)
+		}
+		if err != nil {
+			return nil, err
+		}
+		neighs = append(neighs, list...)
+	}
+	if command.IsProxy {
+		return neighs, nil
+	}
+	var filtered []netlink.Neigh
+	for _, neigh := range neighs {
+		if command.OptionNud != "" {
+			state, _ := getNeighState(command.OptionNud)
+			if neigh.State != state {
+				continue
+			}
+		} else if command.Operation == parser.NEIGHFLUSH &&
+			neigh.State&(netlink.NUD_PERMANENT|netlink.NUD_NOARP) != 0 {
+			// same as iproute2, flush keeps static entries unless nud is given
+			continue
+		}
+		filtered = append(filtered, neigh)
+	}
+	return filtered, nil
+}
+
+// ShowFlushNeigh shows or flushes neighbors in the namespace
+func ShowFlushNeigh(command *parser.Command) (err error) {
+	if command.OptionLladdr != "" {
+		return fmt.Errorf("neighbor show/flush supports only dev, nud and proxy")
+	}
+	if command.OptionNud != "" {
+		if _, err = getNeighState(command.OptionNud); err != nil {
+			return err
+		}
+	}
+	if command.Operation == parser.NEIGHFLUSH && command.OptionDev == "" {
+		return fmt.Errorf("neighbor flush requires dev")
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		linkIndex, err1 := getNeighLinkIndex(command)
+		if err1 != nil {
+			return err1
+		}
+		neighs, err1 := listNeighs(command, linkIndex)
+		if err1 != nil {
+			return err1
+		}
+		if command.Operation == parser.NEIGHSHOW {
+			for _, neigh := range neighs {
+				fmt.Println(formatNeigh(neigh))
+			}
+			return nil
+		}
+		count := 0
+		for _, neigh := range neighs {
+			if err2 := netlink.NeighDel(&neigh); err2 != nil {
+				if isGone(err2) {
+					continue
+				}
+				return fmt.Errorf("failed to delete neighbor %s (%d neighbors flushed): %v",
+					neigh.IP, count, err2)
+			}
+			count++
+		}
+		fmt.Printf("%d neighbors flushed\n", count)
+		return nil
+	})
+}
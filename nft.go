@@ -0,0 +1,406 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/redhat-nfvpe/koro/parser"
+	"golang.org/x/sys/unix"
+)
+
+// nftTableName is the nftables table which koro owns. nat/filter rules are
+// added only into it, so flush does not touch the rules of others.
+const nftTableName = "koro"
+
+// nftChain describes base chain in koro table
+type nftChain struct {
+	chainType nftables.ChainType
+	hook      *nftables.ChainHook
+	priority  *nftables.ChainPriority
+}
+
+// nftChains are base chains which koro creates on demand
+var nftChains = map[string]nftChain{
+	"prerouting":  {nftables.ChainTypeNAT, nftables.ChainHookPrerouting, nftables.ChainPriorityNATDest},
+	"postrouting": {nftables.ChainTypeNAT, nftables.ChainHookPostrouting, nftables.ChainPriorityNATSource},
+	"input":       {nftables.ChainTypeFilter, nftables.ChainHookInput, nftables.ChainPriorityFilter},
+	"forward":     {nftables.ChainTypeFilter, nftables.ChainHookForward, nftables.ChainPriorityFilter},
+	"output":      {nftables.ChainTypeFilter, nftables.ChainHookOutput, nftables.ChainPriorityFilter},
+}
+
+// l4Protocols are protocols which 'proto' accepts
+var l4Protocols = map[string]byte{
+	"tcp":    unix.IPPROTO_TCP,
+	"udp":    unix.IPPROTO_UDP,
+	"icmp":   unix.IPPROTO_ICMP,
+	"icmpv6": unix.IPPROTO_ICMPV6,
+}
+
+// nftFamily keeps the address family which the rule matches, to check that
+// src/dst/to do not mix IPv4 and IPv6
+type nftFamily struct {
+	family byte
+}
+
+// set sets the family of ip, or returns error if it differs from others
+func (f *nftFamily) set(ip net.IP) error {
+	family := byte(unix.NFPROTO_IPV6)
+	if ip.To4() != nil {
+		family = unix.NFPROTO_IPV4
+	}
+	if f.family != 0 && f.family != family {
+		return fmt.Errorf("address family mismatch in %s", ip)
+	}
+	f.family = family
+	return nil
+}
+
+// ifnameData converts interface name into the data of iifname/oifname
+func ifnameData(name string) []byte {
+	data := make([]byte, unix.IFNAMSIZ)
+	copy(data, name+"\x00")
+	return data
+}
+
+// metaMatch returns expressions which compare meta key with data
+func metaMatch(key expr.MetaKey, data []byte) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: key, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: data},
+	}
+}
+
+// prefixMatch returns expressions which match src (or dst) address with
+// prefix. Bare address is taken as /32 or /128.
+func prefixMatch(prefix string, dst bool, family *nftFamily) ([]expr.Any, error) {
+	ipnet, err := parseRulePrefix(prefix)
+	if err != nil || ipnet == nil {
+		return nil, fmt.Errorf("invalid prefix %q", prefix)
+	}
+	if err = family.set(ipnet.IP); err != nil {
+		return nil, err
+	}
+	ip := ipnet.IP.Mask(ipnet.Mask)
+	offset := uint32(8) // IPv6 source
+	if ip.To4() != nil {
+		ip = ip.To4()
+		offset = 12
+	}
+	if dst {
+		offset += uint32(len(ip))
+	}
+	return []expr.Any{
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader,
+			Offset: offset, Len: uint32(len(ip))},
+		&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: uint32(len(ip)),
+			Mask: []byte(ipnet.Mask), Xor: make([]byte, len(ip))},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ip},
+	}, nil
+}
+
+// parsePort parses port of dport/to
+func parsePort(port string) ([]byte, error) {
+	val, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || val == 0 {
+		return nil, fmt.Errorf("invalid port %q", port)
+	}
+	return binaryutil.BigEndian.PutUint16(uint16(val)), nil
+}
+
+// parseNatTo parses 'to' of snat/dnat, ADDRESS, IPV4:PORT or [IPV6]:PORT
+func parseNatTo(to string) (ip net.IP, port []byte, err error) {
+	if ip = net.ParseIP(to); ip != nil {
+		return ip, nil, nil
+	}
+	host, portStr, err := net.SplitHostPort(to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid to %q", to)
+	}
+	if ip = net.ParseIP(host); ip == nil {
+		return nil, nil, fmt.Errorf("invalid to %q", to)
+	}
+	if port, err = parsePort(portStr); err != nil {
+		return nil, nil, err
+	}
+	return ip, port, nil
+}
+
+// nftComment describes the rule as given in CLI, which is kept as the
+// comment of the rule for 'show'
+func nftComment(command *parser.Command, action string) string {
+	words := []string{action}
+	for _, option := range []struct{ name, val string }{
+		{"src", command.OptionSrc},
+		{"dst", command.OptionDst},
+		{"iif", command.OptionIif},
+		{"oif", command.OptionOif},
+		{"proto", command.OptionProto},
+		{"dport", command.OptionDport},
+		{"to", command.OptionTo},
+	} {
+		if option.val != "" {
+			words = append(words, option.name, option.val)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// nftAction returns the chain and the action of nat/filter rule
+func nftAction(command *parser.Command) (chain, action string) {
+	switch command.Operation {
+	case parser.NATMASQUERADE:
+		return "postrouting", "masquerade"
+	case parser.NATSNAT:
+		return "postrouting", "snat"
+	case parser.NATDNAT:
+		return "prerouting", "dnat"
+	}
+	return command.OptionChain, command.OptionVerdict
+}
+
+// GetNftRule converts from CLI argument to the chain name and expressions
+// of nftables rule
+func GetNftRule(command *parser.Command) (chain string, exprs []expr.Any, err error) {
+	chain, action := nftAction(command)
+	if command.OptionIif != "" && (chain == "postrouting" || chain == "output") {
+		return "", nil, fmt.Errorf("%s does not support iif", action)
+	}
+	if command.OptionOif != "" && (chain == "prerouting" || chain == "input") {
+		return "", nil, fmt.Errorf("%s does not support oif", action)
+	}
+	if command.OptionTo != "" && command.Operation != parser.NATSNAT && command.Operation != parser.NATDNAT {
+		return "", nil, fmt.Errorf("%s does not support to", action)
+	}
+
+	var family nftFamily
+	var matches []expr.Any
+	if command.OptionSrc != "" {
+		match, err := prefixMatch(command.OptionSrc, false, &family)
+		if err != nil {
+			return "", nil, err
+		}
+		matches = append(matches, match...)
+	}
+	if command.OptionDst != "" {
+		match, err := prefixMatch(command.OptionDst, true, &family)
+		if err != nil {
+			return "", nil, err
+		}
+		matches = append(matches, match...)
+	}
+	if command.OptionIif != "" {
+		matches = append(matches, metaMatch(expr.MetaKeyIIFNAME, ifnameData(command.OptionIif))...)
+	}
+	if command.OptionOif != "" {
+		matches = append(matches, metaMatch(expr.MetaKeyOIFNAME, ifnameData(command.OptionOif))...)
+	}
+	if command.OptionProto != "" {
+		proto, ok := l4Protocols[command.OptionProto]
+		if !ok {
+			return "", nil, fmt.Errorf("invalid proto %q", command.OptionProto)
+		}
+		matches = append(matches, metaMatch(expr.MetaKeyL4PROTO, []byte{proto})...)
+	}
+	if command.OptionDport != "" {
+		if command.OptionProto != "tcp" && command.OptionProto != "udp" {
+			return "", nil, fmt.Errorf("dport requires proto tcp or udp")
+		}
+		port, err := parsePort(command.OptionDport)
+		if err != nil {
+			return "", nil, err
+		}
+		matches = append(matches,
+			&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: port})
+	}
+
+	var target []expr.Any
+	switch command.Operation {
+	case parser.NATMASQUERADE:
+		target = []expr.Any{&expr.Masq{}}
+	case parser.NATSNAT, parser.NATDNAT:
+		if command.OptionTo == "" {
+			return "", nil, fmt.Errorf("%s requires to", action)
+		}
+		ip, port, err := parseNatTo(command.OptionTo)
+		if err != nil {
+			return "", nil, err
+		}
+		if err = family.set(ip); err != nil {
+			return "", nil, err
+		}
+		if ip.To4() != nil {
+			ip = ip.To4()
+		}
+		nat := &expr.NAT{Type: expr.NATTypeSourceNAT, Family: uint32(family.family), RegAddrMin: 1}
+		if command.Operation == parser.NATDNAT {
+			nat.Type = expr.NATTypeDestNAT
+		}
+		target = []expr.Any{&expr.Immediate{Register: 1, Data: ip}}
+		if port != nil {
+			if command.OptionProto != "tcp" && command.OptionProto != "udp" {
+				return "", nil, fmt.Errorf("port of to requires proto tcp or udp")
+			}
+			target = append(target, &expr.Immediate{Register: 2, Data: port})
+			nat.RegProtoMin = 2
+		}
+		target = append(target, nat)
+	case parser.FILTERADD:
+		verdict := expr.VerdictAccept
+		if command.OptionVerdict == "drop" {
+			verdict = expr.VerdictDrop
+		}
+		target = []expr.Any{&expr.Verdict{Kind: verdict}}
+	}
+
+	// address/port matches need the family of the packet in inet table
+	if family.family != 0 {
+		exprs = metaMatch(expr.MetaKeyNFPROTO, []byte{family.family})
+	}
+	exprs = append(exprs, matches...)
+	exprs = append(exprs, &expr.Counter{})
+	return chain, append(exprs, target...), nil
+}
+
+// koroTable returns koro table in inet family
+func koroTable() *nftables.Table {
+	return &nftables.Table{Family: nftables.TableFamilyINet, Name: nftTableName}
+}
+
+// hasNftRule checks whether the chain of koro table already has the rule,
+// compared by its comment
+func hasNftRule(conn *nftables.Conn, chainName string, comment string) (bool, error) {
+	chains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+	if err != nil {
+		return false, err
+	}
+	for _, chain := range chains {
+		if chain.Table.Name != nftTableName || chain.Name != chainName {
+			continue
+		}
+		rules, err := conn.GetRules(chain.Table, chain)
+		if err != nil {
+			return false, err
+		}
+		for _, rule := range rules {
+			if c, _ := userdata.GetString(rule.UserData, userdata.TypeComment); c == comment {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// AddNftRule adds nat/filter rule into koro table in the namespace. The
+// table and the chain are created if they do not exist.
+func AddNftRule(command *parser.Command) (err error) {
+	chainName, exprs, err := GetNftRule(command)
+	if err != nil {
+		return err
+	}
+	_, action := nftAction(command)
+	comment := nftComment(command, action)
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		conn, err1 := nftables.New()
+		if err1 != nil {
+			return err1
+		}
+		exists, err1 := hasNftRule(conn, chainName, comment)
+		if err1 != nil {
+			return err1
+		}
+		if exists {
+			if isExisting(command, syscall.EEXIST) {
+				return errUnchanged
+			}
+			return fmt.Errorf("failed to add %s rule: %v", chainName, syscall.EEXIST)
+		}
+		table := conn.AddTable(koroTable())
+		spec := nftChains[chainName]
+		chain := conn.AddChain(&nftables.Chain{
+			Name:     chainName,
+			Table:    table,
+			Type:     spec.chainType,
+			Hooknum:  spec.hook,
+			Priority: spec.priority,
+		})
+		conn.AddRule(&nftables.Rule{
+			Table:    table,
+			Chain:    chain,
+			Exprs:    exprs,
+			UserData: userdata.AppendString(nil, userdata.TypeComment, comment),
+		})
+		if err1 = conn.Flush(); err1 != nil {
+			return fmt.Errorf("failed to add %s rule: %v", chainName, err1)
+		}
+		return nil
+	})
+}
+
+// ShowFlushNft shows or flushes nat/filter rules in koro table
+func ShowFlushNft(command *parser.Command) (err error) {
+	chainNames := []string{"prerouting", "postrouting"}
+	if command.Operation == parser.FILTERSHOW || command.Operation == parser.FILTERFLUSH {
+		chainNames = []string{"input", "forward", "output"}
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		conn, err1 := nftables.New()
+		if err1 != nil {
+			return err1
+		}
+		chains, err1 := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+		if err1 != nil {
+			return err1
+		}
+		count := 0
+		for _, name := range chainNames {
+			for _, chain := range chains {
+				if chain.Table.Name != nftTableName || chain.Name != name {
+					continue
+				}
+				rules, err1 := conn.GetRules(chain.Table, chain)
+				if err1 != nil {
+					return err1
+				}
+				count += len(rules)
+				if command.Operation == parser.NATFLUSH || command.Operation == parser.FILTERFLUSH {
+					conn.FlushChain(chain)
+					continue
+				}
+				for _, rule := range rules {
+					comment, _ := userdata.GetString(rule.UserData, userdata.TypeComment)
+					fmt.Printf("%s handle %d: %s\n", name, rule.Handle, comment)
+				}
+			}
+		}
+		if command.Operation == parser.NATFLUSH || command.Operation == parser.FILTERFLUSH {
+			if err1 = conn.Flush(); err1 != nil {
+				return err1
+			}
+			fmt.Printf("%d rules flushed\n", count)
+		}
+		return nil
+	})
+}
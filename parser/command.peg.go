@@ -16,13 +16,40 @@ const (
 	ruleUnknown pegRule = iota
 	ruleroot
 	ruleEOT
+	ruleflags
+	ruleflag
 	rulenetns
 	rulenetnsid
 	ruleoperation
 	rulenetwork
+	ruleaddrprefix
 	ruleaddrstr
 	rulelen
 	ruleoption
+	ruleencap
+	ruleseg6localoption
+	ruleaddroption
+	rulevethend0
+	rulevethend1
+	rulevethaddress
+	rulelinkname
+	rulelinktype
+	rulelinkaddoption
+	rulelinkoption
+	ruleqdisckind
+	ruleqdiscoption
+	rulefilterchain
+	rulefilterverdict
+	rulenftoption
+	ruleconntrackoption
+	rulesysctlkey
+	rulemoveoption
+	ruleneighaddr
+	ruleneighoption
+	ruleroutegetoption
+	rulerulefamily
+	ruleroutefamily
+	ruleruleoption
 	rulespaces
 	rulePegText
 	ruleAction0
@@ -52,19 +79,217 @@ const (
 	ruleAction24
 	ruleAction25
 	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
+	ruleAction38
+	ruleAction39
+	ruleAction40
+	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
+	ruleAction74
+	ruleAction75
+	ruleAction76
+	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
+	ruleAction86
+	ruleAction87
+	ruleAction88
+	ruleAction89
+	ruleAction90
+	ruleAction91
+	ruleAction92
+	ruleAction93
+	ruleAction94
+	ruleAction95
+	ruleAction96
+	ruleAction97
+	ruleAction98
+	ruleAction99
+	ruleAction100
+	ruleAction101
+	ruleAction102
+	ruleAction103
+	ruleAction104
+	ruleAction105
+	ruleAction106
+	ruleAction107
+	ruleAction108
+	ruleAction109
+	ruleAction110
+	ruleAction111
+	ruleAction112
+	ruleAction113
+	ruleAction114
+	ruleAction115
+	ruleAction116
+	ruleAction117
+	ruleAction118
+	ruleAction119
+	ruleAction120
+	ruleAction121
+	ruleAction122
+	ruleAction123
+	ruleAction124
+	ruleAction125
+	ruleAction126
+	ruleAction127
+	ruleAction128
+	ruleAction129
+	ruleAction130
+	ruleAction131
+	ruleAction132
+	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
+	ruleAction189
+	ruleAction190
+	ruleAction191
+	ruleAction192
+	ruleAction193
+	ruleAction194
+	ruleAction195
+	ruleAction196
+	ruleAction197
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"root",
 	"EOT",
+	"flags",
+	"flag",
 	"netns",
 	"netnsid",
 	"operation",
 	"network",
+	"addrprefix",
 	"addrstr",
 	"len",
 	"option",
+	"encap",
+	"seg6localoption",
+	"addroption",
+	"vethend0",
+	"vethend1",
+	"vethaddress",
+	"linkname",
+	"linktype",
+	"linkaddoption",
+	"linkoption",
+	"qdisckind",
+	"qdiscoption",
+	"filterchain",
+	"filterverdict",
+	"nftoption",
+	"conntrackoption",
+	"sysctlkey",
+	"moveoption",
+	"neighaddr",
+	"neighoption",
+	"routegetoption",
+	"rulefamily",
+	"routefamily",
+	"ruleoption",
 	"spaces",
 	"PegText",
 	"Action0",
@@ -94,6 +319,177 @@ var rul3s = [...]string{
 	"Action24",
 	"Action25",
 	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
+	"Action38",
+	"Action39",
+	"Action40",
+	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
+	"Action74",
+	"Action75",
+	"Action76",
+	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
+	"Action86",
+	"Action87",
+	"Action88",
+	"Action89",
+	"Action90",
+	"Action91",
+	"Action92",
+	"Action93",
+	"Action94",
+	"Action95",
+	"Action96",
+	"Action97",
+	"Action98",
+	"Action99",
+	"Action100",
+	"Action101",
+	"Action102",
+	"Action103",
+	"Action104",
+	"Action105",
+	"Action106",
+	"Action107",
+	"Action108",
+	"Action109",
+	"Action110",
+	"Action111",
+	"Action112",
+	"Action113",
+	"Action114",
+	"Action115",
+	"Action116",
+	"Action117",
+	"Action118",
+	"Action119",
+	"Action120",
+	"Action121",
+	"Action122",
+	"Action123",
+	"Action124",
+	"Action125",
+	"Action126",
+	"Action127",
+	"Action128",
+	"Action129",
+	"Action130",
+	"Action131",
+	"Action132",
+	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
+	"Action189",
+	"Action190",
+	"Action191",
+	"Action192",
+	"Action193",
+	"Action194",
+	"Action195",
+	"Action196",
+	"Action197",
 }
 
 type token32 struct {
@@ -210,7 +606,7 @@ type Parser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [39]func() bool
+	rules  [237]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -303,59 +699,406 @@ func (p *Parser) Execute() {
 			text = string(_buffer[begin:end])
 
 		case ruleAction0:
-			p.Err(begin, buffer, "")
-		case ruleAction1:
 			p.TargetType = NSNONE
+		case ruleAction1:
+			p.Err(begin, buffer, "veth takes namespace of each end")
 		case ruleAction2:
 			p.Err(begin, buffer, "")
 		case ruleAction3:
-			p.TargetType = DOCKER
+			p.Err(begin, buffer, "")
 		case ruleAction4:
-			p.TargetType = NETNS
+			p.IgnoreExisting = true
 		case ruleAction5:
-			p.TargetType = PID
+			p.IgnoreMissing = true
 		case ruleAction6:
-			p.Err(begin, buffer, "Invalid namespace")
+			p.FlushConntrack = true
 		case ruleAction7:
-			p.Target = text
+			p.TargetType = DOCKER
 		case ruleAction8:
-			p.Operation = ROUTEADD
+			p.TargetType = NETNS
 		case ruleAction9:
-			p.Operation = ROUTEDEL
+			p.TargetType = IPNETNS
 		case ruleAction10:
-			p.Err(begin, buffer, "Invalid option")
+			p.TargetType = PID
 		case ruleAction11:
-			p.Err(begin, buffer, "invalid network")
+			p.Err(begin, buffer, "Invalid namespace")
 		case ruleAction12:
-			p.Err(begin, buffer, "Invalid option")
+			p.Target = text
 		case ruleAction13:
-			p.Err(begin, buffer, "Invalid network")
+			p.Operation = ROUTEADD
 		case ruleAction14:
-			p.Err(begin, buffer, "")
+			p.Operation = ROUTEDEL
 		case ruleAction15:
-			p.Operation = ADDRADD
+			p.Operation = ROUTEREPLACE
 		case ruleAction16:
-			p.Operation = ADDRDEL
+			p.Operation = ROUTECHANGE
 		case ruleAction17:
 			p.Err(begin, buffer, "Invalid option")
 		case ruleAction18:
-			p.Err(begin, buffer, "Invalid address")
+			p.Err(begin, buffer, "invalid network")
 		case ruleAction19:
 			p.Err(begin, buffer, "Invalid option")
 		case ruleAction20:
-			p.Err(begin, buffer, "Invalid address")
+			p.Err(begin, buffer, "Invalid network")
 		case ruleAction21:
-			p.IsDefault = false
+			p.Err(begin, buffer, "Invalid option")
 		case ruleAction22:
-			p.IsDefault = true
+			p.Err(begin, buffer, "Invalid network")
 		case ruleAction23:
-			p.Network = text
+			p.Err(begin, buffer, "Invalid option")
 		case ruleAction24:
-			p.NetworkLength = text
+			p.Err(begin, buffer, "Invalid network")
 		case ruleAction25:
-			p.SetOption("via", text)
+			p.Operation = ROUTEFLUSH
 		case ruleAction26:
+			p.Operation = ROUTESHOW
+		case ruleAction27:
+			p.SetOption("to", text)
+		case ruleAction28:
+			p.Operation = ROUTEGET
+		case ruleAction29:
+			p.Err(begin, buffer, "")
+		case ruleAction30:
+			p.Operation = ADDRADD
+		case ruleAction31:
+			p.Operation = ADDRDEL
+		case ruleAction32:
+			p.Err(begin, buffer, "Invalid option")
+		case ruleAction33:
+			p.Err(begin, buffer, "Invalid address")
+		case ruleAction34:
+			p.Err(begin, buffer, "Invalid option")
+		case ruleAction35:
+			p.Err(begin, buffer, "Invalid address")
+		case ruleAction36:
+			p.Operation = ADDRFLUSH
+		case ruleAction37:
+			p.Operation = RULEADD
+		case ruleAction38:
+			p.Operation = RULEDEL
+		case ruleAction39:
+			p.Operation = RULESHOW
+		case ruleAction40:
+			p.Err(begin, buffer, "Invalid rule")
+		case ruleAction41:
+			p.Operation = VETHADD
+		case ruleAction42:
+			p.Operation = LINKADD
+		case ruleAction43:
+			p.Operation = LINKSET
+		case ruleAction44:
+			p.Operation = LINKSHOW
+		case ruleAction45:
+			p.Operation = LINKADOPT
+		case ruleAction46:
+			p.Operation = LINKRELEASE
+		case ruleAction47:
+			p.Err(begin, buffer, "Invalid link")
+		case ruleAction48:
+			p.Operation = NEIGHADD
+		case ruleAction49:
+			p.Operation = NEIGHDEL
+		case ruleAction50:
+			p.Operation = NEIGHREPLACE
+		case ruleAction51:
+			p.Operation = NEIGHSHOW
+		case ruleAction52:
+			p.Operation = NEIGHFLUSH
+		case ruleAction53:
+			p.Err(begin, buffer, "Invalid neighbor")
+		case ruleAction54:
+			p.Operation = VRFSHOW
+		case ruleAction55:
+			p.Operation = QDISCADD
+		case ruleAction56:
+			p.Operation = QDISCREPLACE
+		case ruleAction57:
+			p.Operation = QDISCDEL
+		case ruleAction58:
+			p.Operation = QDISCSHOW
+		case ruleAction59:
+			p.Err(begin, buffer, "Invalid qdisc")
+		case ruleAction60:
+			p.Operation = CLASSADD
+		case ruleAction61:
+			p.Operation = CLASSREPLACE
+		case ruleAction62:
+			p.Operation = CLASSDEL
+		case ruleAction63:
+			p.Operation = CLASSSHOW
+		case ruleAction64:
+			p.Err(begin, buffer, "Invalid class")
+		case ruleAction65:
+			p.Operation = NATMASQUERADE
+		case ruleAction66:
+			p.Operation = NATSNAT
+		case ruleAction67:
+			p.Operation = NATDNAT
+		case ruleAction68:
+			p.Operation = NATSHOW
+		case ruleAction69:
+			p.Operation = NATFLUSH
+		case ruleAction70:
+			p.Err(begin, buffer, "Invalid nat")
+		case ruleAction71:
+			p.Operation = FILTERSHOW
+		case ruleAction72:
+			p.Operation = FILTERFLUSH
+		case ruleAction73:
+			p.Operation = FILTERADD
+		case ruleAction74:
+			p.Err(begin, buffer, "Invalid filter")
+		case ruleAction75:
+			p.Operation = CONNTRACKSHOW
+		case ruleAction76:
+			p.Operation = CONNTRACKFLUSH
+		case ruleAction77:
+			p.Err(begin, buffer, "Invalid conntrack")
+		case ruleAction78:
+			p.Operation = SYSCTLGET
+		case ruleAction79:
+			p.SetOption("value", text)
+		case ruleAction80:
+			p.Operation = SYSCTLSET
+		case ruleAction81:
+			p.Err(begin, buffer, "Invalid sysctl")
+		case ruleAction82:
+			p.Err(begin, buffer, "Invalid vrf")
+		case ruleAction83:
+			p.SetOption("mpls", text)
+		case ruleAction84:
+			p.IsDefault = true
+		case ruleAction85:
+			p.IsDefault = false
+		case ruleAction86:
+			p.IsDefault = false
+		case ruleAction87:
+			p.Network = text
+		case ruleAction88:
+			p.NetworkLength = text
+		case ruleAction89:
+			p.IsViaInet6 = true
+			p.SetOption("via", text)
+		case ruleAction90:
+			p.SetOption("via", text)
+		case ruleAction91:
+			p.SetOption("dev", text)
+		case ruleAction92:
+			p.SetOption("table", text)
+		case ruleAction93:
+			p.SetOption("proto", text)
+		case ruleAction94:
+			p.SetOption("scope", text)
+		case ruleAction95:
+			p.SetOption("vrf", text)
+		case ruleAction96:
+			p.IsOnlink = true
+		case ruleAction97:
+			p.SetOption("pref", text)
+		case ruleAction98:
+			p.SetOption("expires", text)
+		case ruleAction99:
+			p.SetOption("as", text)
+		case ruleAction100:
+			p.SetOption("encap", "mpls")
+			p.SetOption("labels", text)
+		case ruleAction101:
+			p.SetOption("encap", "seg6local")
+			p.SetOption("action", text)
+		case ruleAction102:
+			p.SetOption("encap", "seg6")
+			p.SetOption("seg6mode", text)
+		case ruleAction103:
+			p.SetOption("segs", text)
+		case ruleAction104:
+			p.SetOption("nh6", text)
+		case ruleAction105:
+			p.SetOption("localtable", text)
+		case ruleAction106:
+			p.SetOption("vrftable", text)
+		case ruleAction107:
+			p.SetOption("dev", text)
+		case ruleAction108:
+			p.SetOption("peer", text)
+		case ruleAction109:
+			p.SetOption("broadcast", text)
+		case ruleAction110:
+			p.SetOption("label", text)
+		case ruleAction111:
+			p.SetOption("scope", text)
+		case ruleAction112:
+			p.SetOption("valid_lft", text)
+		case ruleAction113:
+			p.SetOption("preferred_lft", text)
+		case ruleAction114:
+			p.IsNodad = true
+		case ruleAction115:
+			p.IsNoprefixroute = true
+		case ruleAction116:
+			p.IsHome = true
+		case ruleAction117:
+			p.IsMngtmpaddr = true
+		case ruleAction118:
+			p.Veth[0].Name = text
+		case ruleAction119:
+			p.SetVethNS(0)
+		case ruleAction120:
+			p.Veth[1].Name = text
+		case ruleAction121:
+			p.SetVethNS(1)
+		case ruleAction122:
+			p.Veth[0].Address = text
+		case ruleAction123:
+			p.Veth[1].Address = text
+		case ruleAction124:
+			p.SetOption("dev", text)
+		case ruleAction125:
+			p.SetOption("type", text)
+		case ruleAction126:
+			p.SetOption("parent", text)
+		case ruleAction127:
+			p.SetOption("parent", text)
+		case ruleAction128:
+			p.SetOption("local", text)
+		case ruleAction129:
+			p.SetOption("remote", text)
+		case ruleAction130:
+			p.SetOption("dstport", text)
+		case ruleAction131:
+			p.SetOption("stp", text)
+		case ruleAction132:
+			p.SetOption("vlan_filtering", text)
+		case ruleAction133:
+			p.SetOption("miimon", text)
+		case ruleAction134:
+			p.SetOption("table", text)
+		case ruleAction135:
+			p.SetOption("id", text)
+		case ruleAction136:
+			p.SetOption("mode", text)
+		case ruleAction137:
+			p.SetOption("name", text)
+		case ruleAction138:
+			p.SetOption("state", "up")
+		case ruleAction139:
+			p.SetOption("state", "up")
+		case ruleAction140:
+			p.SetOption("state", "down")
+		case ruleAction141:
+			p.SetOption("mtu", text)
+		case ruleAction142:
+			p.SetOption("lladdr", text)
+		case ruleAction143:
+			p.SetOption("name", text)
+		case ruleAction144:
+			p.SetOption("txqueuelen", text)
+		case ruleAction145:
+			p.SetOption("alias", text)
+		case ruleAction146:
+			p.SetOption("master", text)
+		case ruleAction147:
+			p.IsNomaster = true
+		case ruleAction148:
+			p.SetOption("type", text)
+		case ruleAction149:
+			p.SetOption("dev", text)
+		case ruleAction150:
+			p.SetOption("parent", text)
+		case ruleAction151:
+			p.SetOption("handle", text)
+		case ruleAction152:
+			p.SetOption("classid", text)
+		case ruleAction153:
+			p.SetOption("delay", text)
+		case ruleAction154:
+			p.SetOption("jitter", text)
+		case ruleAction155:
+			p.SetOption("loss", text)
+		case ruleAction156:
+			p.SetOption("duplicate", text)
+		case ruleAction157:
+			p.SetOption("rate", text)
+		case ruleAction158:
+			p.SetOption("ceil", text)
+		case ruleAction159:
+			p.SetOption("burst", text)
+		case ruleAction160:
+			p.SetOption("latency", text)
+		case ruleAction161:
+			p.SetOption("default", text)
+		case ruleAction162:
+			p.SetOption("parent", "root")
+		case ruleAction163:
+			p.SetOption("chain", text)
+		case ruleAction164:
+			p.SetOption("verdict", text)
+		case ruleAction165:
+			p.SetOption("src", text)
+		case ruleAction166:
+			p.SetOption("dst", text)
+		case ruleAction167:
+			p.SetOption("iif", text)
+		case ruleAction168:
+			p.SetOption("oif", text)
+		case ruleAction169:
+			p.SetOption("proto", text)
+		case ruleAction170:
+			p.SetOption("dport", text)
+		case ruleAction171:
+			p.SetOption("to", text)
+		case ruleAction172:
+			p.SetOption("src", text)
+		case ruleAction173:
+			p.SetOption("dst", text)
+		case ruleAction174:
+			p.SetOption("proto", text)
+		case ruleAction175:
+			p.SetOption("key", text)
+		case ruleAction176:
+			p.SetOption("name", text)
+		case ruleAction177:
+			p.IsKeepaddr = true
+		case ruleAction178:
+			p.IsKeepstate = true
+		case ruleAction179:
+			p.IsProxy = true
+			p.SetOption("neighbor", text)
+		case ruleAction180:
+			p.SetOption("neighbor", text)
+		case ruleAction181:
+			p.SetOption("lladdr", text)
+		case ruleAction182:
 			p.SetOption("dev", text)
+		case ruleAction183:
+			p.SetOption("nud", text)
+		case ruleAction184:
+			p.IsProxy = true
+		case ruleAction185:
+			p.SetOption("from", text)
+		case ruleAction186:
+			p.SetOption("iif", text)
+		case ruleAction187:
+			p.SetOption("fwmark", text)
+		case ruleAction188:
+			p.SetOption("family", text)
+		case ruleAction189:
+			p.SetOption("family", text)
+		case ruleAction190:
+			p.IsNot = true
+		case ruleAction191:
+			p.SetOption("from", text)
+		case ruleAction192:
+			p.SetOption("to", text)
+		case ruleAction193:
+			p.SetOption("iif", text)
+		case ruleAction194:
+			p.SetOption("oif", text)
+		case ruleAction195:
+			p.SetOption("fwmark", text)
+		case ruleAction196:
+			p.SetOption("table", text)
+		case ruleAction197:
+			p.SetOption("priority", text)
 
 		}
 	}
@@ -430,20 +1173,20 @@ func (p *Parser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 root <- <((netns spaces operation EOT) / (netns spaces <.+> Action0 EOT) / (operation Action1 EOT) / (<.+> Action2 EOT))> */
+		/* 0 root <- <((flags operation Action0 EOT) / (flags netns spaces ('l' 'i' 'n' 'k') spaces ('a' 'd' 'd') spaces ('v' 'e' 't' 'h') spaces <.+> Action1 EOT) / (flags netns spaces operation EOT) / (flags netns spaces <.+> Action2 EOT) / (<.+> Action3 EOT))> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
 				{
 					position2, tokenIndex2 := position, tokenIndex
-					if !_rules[rulenetns]() {
+					if !_rules[ruleflags]() {
 						goto l3
 					}
-					if !_rules[rulespaces]() {
+					if !_rules[ruleoperation]() {
 						goto l3
 					}
-					if !_rules[ruleoperation]() {
+					if !_rules[ruleAction0]() {
 						goto l3
 					}
 					if !_rules[ruleEOT]() {
@@ -452,12 +1195,68 @@ func (p *Parser) Init() {
 					goto l2
 				l3:
 					position, tokenIndex = position2, tokenIndex2
+					if !_rules[ruleflags]() {
+						goto l4
+					}
 					if !_rules[rulenetns]() {
 						goto l4
 					}
 					if !_rules[rulespaces]() {
 						goto l4
 					}
+					if buffer[position] != rune('l') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l4
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l4
+					}
+					if buffer[position] != rune('a') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l4
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l4
+					}
+					if buffer[position] != rune('v') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l4
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l4
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l4
+					}
 					{
 						position5 := position
 						if !matchDot() {
@@ -475,7 +1274,7 @@ func (p *Parser) Init() {
 						}
 						add(rulePegText, position5)
 					}
-					if !_rules[ruleAction0]() {
+					if !_rules[ruleAction1]() {
 						goto l4
 					}
 					if !_rules[ruleEOT]() {
@@ -484,10 +1283,16 @@ func (p *Parser) Init() {
 					goto l2
 				l4:
 					position, tokenIndex = position2, tokenIndex2
-					if !_rules[ruleoperation]() {
+					if !_rules[ruleflags]() {
 						goto l8
 					}
-					if !_rules[ruleAction1]() {
+					if !_rules[rulenetns]() {
+						goto l8
+					}
+					if !_rules[rulespaces]() {
+						goto l8
+					}
+					if !_rules[ruleoperation]() {
 						goto l8
 					}
 					if !_rules[ruleEOT]() {
@@ -495,25 +1300,60 @@ func (p *Parser) Init() {
 					}
 					goto l2
 				l8:
+					position, tokenIndex = position2, tokenIndex2
+					if !_rules[ruleflags]() {
+						goto l9
+					}
+					if !_rules[rulenetns]() {
+						goto l9
+					}
+					if !_rules[rulespaces]() {
+						goto l9
+					}
+					{
+						position10 := position
+						if !matchDot() {
+							goto l9
+						}
+					l11:
+						{
+							position12, tokenIndex12 := position, tokenIndex
+							if !matchDot() {
+								goto l12
+							}
+							goto l11
+						l12:
+							position, tokenIndex = position12, tokenIndex12
+						}
+						add(rulePegText, position10)
+					}
+					if !_rules[ruleAction2]() {
+						goto l9
+					}
+					if !_rules[ruleEOT]() {
+						goto l9
+					}
+					goto l2
+				l9:
 					position, tokenIndex = position2, tokenIndex2
 					{
-						position9 := position
+						position13 := position
 						if !matchDot() {
 							goto l0
 						}
-					l10:
+					l14:
 						{
-							position11, tokenIndex11 := position, tokenIndex
+							position15, tokenIndex15 := position, tokenIndex
 							if !matchDot() {
-								goto l11
+								goto l15
 							}
-							goto l10
-						l11:
-							position, tokenIndex = position11, tokenIndex11
+							goto l14
+						l15:
+							position, tokenIndex = position15, tokenIndex15
 						}
-						add(rulePegText, position9)
+						add(rulePegText, position13)
 					}
-					if !_rules[ruleAction2]() {
+					if !_rules[ruleAction3]() {
 						goto l0
 					}
 					if !_rules[ruleEOT]() {
@@ -530,468 +1370,506 @@ func (p *Parser) Init() {
 		},
 		/* 1 EOT <- <!.> */
 		func() bool {
-			position12, tokenIndex12 := position, tokenIndex
+			position16, tokenIndex16 := position, tokenIndex
 			{
-				position13 := position
+				position17 := position
 				{
-					position14, tokenIndex14 := position, tokenIndex
+					position18, tokenIndex18 := position, tokenIndex
 					if !matchDot() {
-						goto l14
+						goto l18
 					}
-					goto l12
-				l14:
-					position, tokenIndex = position14, tokenIndex14
+					goto l16
+				l18:
+					position, tokenIndex = position18, tokenIndex18
 				}
-				add(ruleEOT, position13)
+				add(ruleEOT, position17)
 			}
 			return true
-		l12:
-			position, tokenIndex = position12, tokenIndex12
+		l16:
+			position, tokenIndex = position16, tokenIndex16
 			return false
 		},
-		/* 2 netns <- <(('d' 'o' 'c' 'k' 'e' 'r' spaces netnsid Action3) / ('n' 'e' 't' 'n' 's' spaces netnsid Action4) / ('p' 'i' 'd' spaces netnsid Action5) / (<.+> Action6 EOT))> */
+		/* 2 flags <- <(flag spaces)*> */
 		func() bool {
-			position15, tokenIndex15 := position, tokenIndex
 			{
-				position16 := position
+				position20 := position
+			l21:
 				{
-					position17, tokenIndex17 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l18
+					position22, tokenIndex22 := position, tokenIndex
+					if !_rules[ruleflag]() {
+						goto l22
 					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l18
+					if !_rules[rulespaces]() {
+						goto l22
+					}
+					goto l21
+				l22:
+					position, tokenIndex = position22, tokenIndex22
+				}
+				add(ruleflags, position20)
+			}
+			return true
+		},
+		/* 3 flag <- <(('-' '-' 'i' 'g' 'n' 'o' 'r' 'e' '-' 'e' 'x' 'i' 's' 't' 'i' 'n' 'g' Action4) / ('-' '-' 'i' 'g' 'n' 'o' 'r' 'e' '-' 'm' 'i' 's' 's' 'i' 'n' 'g' Action5) / ('-' '-' 'f' 'l' 'u' 's' 'h' '-' 'c' 'o' 'n' 'n' 't' 'r' 'a' 'c' 'k' Action6))> */
+		func() bool {
+			position23, tokenIndex23 := position, tokenIndex
+			{
+				position24 := position
+				{
+					position25, tokenIndex25 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('c') {
-						goto l18
+					if buffer[position] != rune('-') {
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('k') {
-						goto l18
+					if buffer[position] != rune('i') {
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l18
+					if buffer[position] != rune('g') {
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l18
+					if buffer[position] != rune('n') {
+						goto l26
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l18
+					if buffer[position] != rune('o') {
+						goto l26
 					}
-					if !_rules[rulenetnsid]() {
-						goto l18
+					position++
+					if buffer[position] != rune('r') {
+						goto l26
 					}
-					if !_rules[ruleAction3]() {
-						goto l18
+					position++
+					if buffer[position] != rune('e') {
+						goto l26
 					}
-					goto l17
-				l18:
-					position, tokenIndex = position17, tokenIndex17
-					if buffer[position] != rune('n') {
-						goto l19
+					position++
+					if buffer[position] != rune('-') {
+						goto l26
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l19
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l19
+					if buffer[position] != rune('x') {
+						goto l26
 					}
 					position++
-					if buffer[position] != rune('n') {
-						goto l19
+					if buffer[position] != rune('i') {
+						goto l26
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l19
+						goto l26
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l19
+					if buffer[position] != rune('t') {
+						goto l26
 					}
-					if !_rules[rulenetnsid]() {
-						goto l19
+					position++
+					if buffer[position] != rune('i') {
+						goto l26
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l26
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l26
 					}
+					position++
 					if !_rules[ruleAction4]() {
-						goto l19
+						goto l26
 					}
-					goto l17
-				l19:
-					position, tokenIndex = position17, tokenIndex17
-					if buffer[position] != rune('p') {
-						goto l20
+					goto l25
+				l26:
+					position, tokenIndex = position25, tokenIndex25
+					if buffer[position] != rune('-') {
+						goto l27
+					}
+					position++
+					if buffer[position] != rune('-') {
+						goto l27
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l20
+						goto l27
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l20
+					if buffer[position] != rune('g') {
+						goto l27
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l20
+					if buffer[position] != rune('n') {
+						goto l27
 					}
-					if !_rules[rulenetnsid]() {
-						goto l20
+					position++
+					if buffer[position] != rune('o') {
+						goto l27
 					}
-					if !_rules[ruleAction5]() {
-						goto l20
+					position++
+					if buffer[position] != rune('r') {
+						goto l27
 					}
-					goto l17
-				l20:
-					position, tokenIndex = position17, tokenIndex17
-					{
-						position21 := position
-						if !matchDot() {
-							goto l15
-						}
-					l22:
-						{
-							position23, tokenIndex23 := position, tokenIndex
-							if !matchDot() {
-								goto l23
-							}
-							goto l22
-						l23:
-							position, tokenIndex = position23, tokenIndex23
-						}
-						add(rulePegText, position21)
+					position++
+					if buffer[position] != rune('e') {
+						goto l27
 					}
-					if !_rules[ruleAction6]() {
-						goto l15
+					position++
+					if buffer[position] != rune('-') {
+						goto l27
 					}
-					if !_rules[ruleEOT]() {
-						goto l15
+					position++
+					if buffer[position] != rune('m') {
+						goto l27
 					}
-				}
-			l17:
-				add(rulenetns, position16)
-			}
-			return true
-		l15:
-			position, tokenIndex = position15, tokenIndex15
-			return false
-		},
-		/* 3 netnsid <- <(<(!' ' .)+> Action7)> */
-		func() bool {
-			position24, tokenIndex24 := position, tokenIndex
-			{
-				position25 := position
-				{
-					position26 := position
-					{
-						position29, tokenIndex29 := position, tokenIndex
-						if buffer[position] != rune(' ') {
-							goto l29
-						}
-						position++
-						goto l24
-					l29:
-						position, tokenIndex = position29, tokenIndex29
+					position++
+					if buffer[position] != rune('i') {
+						goto l27
 					}
-					if !matchDot() {
-						goto l24
+					position++
+					if buffer[position] != rune('s') {
+						goto l27
 					}
-				l27:
-					{
-						position28, tokenIndex28 := position, tokenIndex
-						{
-							position30, tokenIndex30 := position, tokenIndex
-							if buffer[position] != rune(' ') {
-								goto l30
-							}
-							position++
-							goto l28
-						l30:
-							position, tokenIndex = position30, tokenIndex30
-						}
-						if !matchDot() {
-							goto l28
-						}
+					position++
+					if buffer[position] != rune('s') {
 						goto l27
-					l28:
-						position, tokenIndex = position28, tokenIndex28
-					}
-					add(rulePegText, position26)
-				}
-				if !_rules[ruleAction7]() {
-					goto l24
-				}
-				add(rulenetnsid, position25)
-			}
-			return true
-		l24:
-			position, tokenIndex = position24, tokenIndex24
-			return false
-		},
-		/* 4 operation <- <(('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces network (spaces option)* Action8) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces network (spaces option)* Action9) / ('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces network spaces <.+> Action10 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces <.+> Action11 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces network spaces <.+> Action12 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces <.+> Action13 EOT) / ('r' 'o' 'u' 't' 'e' spaces <.+> Action14 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces network spaces option Action15) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces network spaces option Action16) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces network spaces <.+> Action17 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces <.+> Action18 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces network spaces <.+> Action19 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces <.+> Action20 EOT) / )> */
-		func() bool {
-			{
-				position32 := position
-				{
-					position33, tokenIndex33 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l34
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l34
+					if buffer[position] != rune('i') {
+						goto l27
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l34
+					if buffer[position] != rune('n') {
+						goto l27
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l34
+					if buffer[position] != rune('g') {
+						goto l27
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l34
+					if !_rules[ruleAction5]() {
+						goto l27
 					}
-					position++
-					if !_rules[rulespaces]() {
-						goto l34
+					goto l25
+				l27:
+					position, tokenIndex = position25, tokenIndex25
+					if buffer[position] != rune('-') {
+						goto l23
 					}
-					if buffer[position] != rune('a') {
-						goto l34
+					position++
+					if buffer[position] != rune('-') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l34
+					if buffer[position] != rune('f') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l34
+					if buffer[position] != rune('l') {
+						goto l23
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l34
+					if buffer[position] != rune('u') {
+						goto l23
 					}
-					if !_rules[rulenetwork]() {
-						goto l34
+					position++
+					if buffer[position] != rune('s') {
+						goto l23
 					}
-				l35:
-					{
-						position36, tokenIndex36 := position, tokenIndex
-						if !_rules[rulespaces]() {
-							goto l36
-						}
-						if !_rules[ruleoption]() {
-							goto l36
-						}
-						goto l35
-					l36:
-						position, tokenIndex = position36, tokenIndex36
+					position++
+					if buffer[position] != rune('h') {
+						goto l23
 					}
-					if !_rules[ruleAction8]() {
-						goto l34
+					position++
+					if buffer[position] != rune('-') {
+						goto l23
 					}
-					goto l33
-				l34:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('r') {
-						goto l37
+					position++
+					if buffer[position] != rune('c') {
+						goto l23
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l37
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l37
+					if buffer[position] != rune('n') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l37
+					if buffer[position] != rune('n') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l37
+					if buffer[position] != rune('t') {
+						goto l23
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l37
-					}
-					if buffer[position] != rune('d') {
-						goto l37
+					if buffer[position] != rune('r') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l37
+					if buffer[position] != rune('a') {
+						goto l23
 					}
 					position++
-					if buffer[position] != rune('l') {
-						goto l37
+					if buffer[position] != rune('c') {
+						goto l23
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l37
-					}
-					if !_rules[rulenetwork]() {
-						goto l37
-					}
-				l38:
-					{
-						position39, tokenIndex39 := position, tokenIndex
-						if !_rules[rulespaces]() {
-							goto l39
-						}
-						if !_rules[ruleoption]() {
-							goto l39
-						}
-						goto l38
-					l39:
-						position, tokenIndex = position39, tokenIndex39
+					if buffer[position] != rune('k') {
+						goto l23
 					}
-					if !_rules[ruleAction9]() {
-						goto l37
+					position++
+					if !_rules[ruleAction6]() {
+						goto l23
 					}
-					goto l33
-				l37:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('r') {
-						goto l40
+				}
+			l25:
+				add(ruleflag, position24)
+			}
+			return true
+		l23:
+			position, tokenIndex = position23, tokenIndex23
+			return false
+		},
+		/* 4 netns <- <(('d' 'o' 'c' 'k' 'e' 'r' spaces netnsid Action7) / ('n' 'e' 't' 'n' 's' spaces netnsid Action8) / ('i' 'p' 'n' 'e' 't' 'n' 's' spaces netnsid Action9) / ('p' 'i' 'd' spaces netnsid Action10) / (<.+> Action11 EOT))> */
+		func() bool {
+			position28, tokenIndex28 := position, tokenIndex
+			{
+				position29 := position
+				{
+					position30, tokenIndex30 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l31
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l40
+						goto l31
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l40
+					if buffer[position] != rune('c') {
+						goto l31
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l40
+					if buffer[position] != rune('k') {
+						goto l31
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l40
+						goto l31
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l31
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l40
+						goto l31
 					}
-					if buffer[position] != rune('a') {
-						goto l40
+					if !_rules[rulenetnsid]() {
+						goto l31
+					}
+					if !_rules[ruleAction7]() {
+						goto l31
+					}
+					goto l30
+				l31:
+					position, tokenIndex = position30, tokenIndex30
+					if buffer[position] != rune('n') {
+						goto l32
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l40
+					if buffer[position] != rune('e') {
+						goto l32
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l40
+					if buffer[position] != rune('t') {
+						goto l32
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l40
+					if buffer[position] != rune('n') {
+						goto l32
 					}
-					if !_rules[rulenetwork]() {
-						goto l40
+					position++
+					if buffer[position] != rune('s') {
+						goto l32
 					}
+					position++
 					if !_rules[rulespaces]() {
-						goto l40
+						goto l32
 					}
-					{
-						position41 := position
-						if !matchDot() {
-							goto l40
-						}
-					l42:
-						{
-							position43, tokenIndex43 := position, tokenIndex
-							if !matchDot() {
-								goto l43
-							}
-							goto l42
-						l43:
-							position, tokenIndex = position43, tokenIndex43
-						}
-						add(rulePegText, position41)
+					if !_rules[rulenetnsid]() {
+						goto l32
 					}
-					if !_rules[ruleAction10]() {
-						goto l40
+					if !_rules[ruleAction8]() {
+						goto l32
 					}
-					if !_rules[ruleEOT]() {
-						goto l40
+					goto l30
+				l32:
+					position, tokenIndex = position30, tokenIndex30
+					if buffer[position] != rune('i') {
+						goto l33
 					}
-					goto l33
-				l40:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('r') {
-						goto l44
+					position++
+					if buffer[position] != rune('p') {
+						goto l33
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l44
+					if buffer[position] != rune('n') {
+						goto l33
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l44
+					if buffer[position] != rune('e') {
+						goto l33
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l44
+						goto l33
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l44
+					if buffer[position] != rune('n') {
+						goto l33
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l33
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l44
+						goto l33
 					}
-					if buffer[position] != rune('a') {
-						goto l44
+					if !_rules[rulenetnsid]() {
+						goto l33
+					}
+					if !_rules[ruleAction9]() {
+						goto l33
+					}
+					goto l30
+				l33:
+					position, tokenIndex = position30, tokenIndex30
+					if buffer[position] != rune('p') {
+						goto l34
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l44
+					if buffer[position] != rune('i') {
+						goto l34
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l44
+						goto l34
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l44
+						goto l34
+					}
+					if !_rules[rulenetnsid]() {
+						goto l34
 					}
+					if !_rules[ruleAction10]() {
+						goto l34
+					}
+					goto l30
+				l34:
+					position, tokenIndex = position30, tokenIndex30
 					{
-						position45 := position
+						position35 := position
 						if !matchDot() {
-							goto l44
+							goto l28
 						}
-					l46:
+					l36:
 						{
-							position47, tokenIndex47 := position, tokenIndex
+							position37, tokenIndex37 := position, tokenIndex
 							if !matchDot() {
-								goto l47
+								goto l37
 							}
-							goto l46
-						l47:
-							position, tokenIndex = position47, tokenIndex47
+							goto l36
+						l37:
+							position, tokenIndex = position37, tokenIndex37
 						}
-						add(rulePegText, position45)
+						add(rulePegText, position35)
 					}
 					if !_rules[ruleAction11]() {
-						goto l44
+						goto l28
 					}
 					if !_rules[ruleEOT]() {
-						goto l44
+						goto l28
+					}
+				}
+			l30:
+				add(rulenetns, position29)
+			}
+			return true
+		l28:
+			position, tokenIndex = position28, tokenIndex28
+			return false
+		},
+		/* 5 netnsid <- <(<(!' ' .)+> Action12)> */
+		func() bool {
+			position38, tokenIndex38 := position, tokenIndex
+			{
+				position39 := position
+				{
+					position40 := position
+					{
+						position43, tokenIndex43 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l43
+						}
+						position++
+						goto l38
+					l43:
+						position, tokenIndex = position43, tokenIndex43
+					}
+					if !matchDot() {
+						goto l38
+					}
+				l41:
+					{
+						position42, tokenIndex42 := position, tokenIndex
+						{
+							position44, tokenIndex44 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l44
+							}
+							position++
+							goto l42
+						l44:
+							position, tokenIndex = position44, tokenIndex44
+						}
+						if !matchDot() {
+							goto l42
+						}
+						goto l41
+					l42:
+						position, tokenIndex = position42, tokenIndex42
 					}
-					goto l33
-				l44:
-					position, tokenIndex = position33, tokenIndex33
+					add(rulePegText, position40)
+				}
+				if !_rules[ruleAction12]() {
+					goto l38
+				}
+				add(rulenetnsid, position39)
+			}
+			return true
+		l38:
+			position, tokenIndex = position38, tokenIndex38
+			return false
+		},
+		/* 6 operation <- <(('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces network (spaces option)* Action13) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces network (spaces option)* Action14) / ('r' 'o' 'u' 't' 'e' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') spaces network (spaces option)* Action15) / ('r' 'o' 'u' 't' 'e' spaces ('c' 'h' 'a' 'n' 'g' 'e') spaces network (spaces option)* Action16) / ('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces network spaces <.+> Action17 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('a' 'd' 'd') spaces <.+> Action18 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces network spaces <.+> Action19 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('d' 'e' 'l') spaces <.+> Action20 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') spaces network spaces <.+> Action21 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') spaces <.+> Action22 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('c' 'h' 'a' 'n' 'g' 'e') spaces network spaces <.+> Action23 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('c' 'h' 'a' 'n' 'g' 'e') spaces <.+> Action24 EOT) / ('r' 'o' 'u' 't' 'e' spaces ('f' 'l' 'u' 's' 'h') (spaces routefamily)? (spaces option)* Action25) / ('r' 'o' 'u' 't' 'e' spaces ('s' 'h' 'o' 'w') (spaces option)* Action26) / ('r' 'o' 'u' 't' 'e' spaces ('g' 'e' 't') spaces <(!' ' .)+> Action27 (spaces routegetoption)* Action28) / ('r' 'o' 'u' 't' 'e' spaces <.+> Action29 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces addrprefix (spaces addroption)* Action30) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces addrprefix (spaces addroption)* Action31) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces addrprefix spaces <.+> Action32 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('a' 'd' 'd') spaces <.+> Action33 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces addrprefix spaces <.+> Action34 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('d' 'e' 'l') spaces <.+> Action35 EOT) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces ('f' 'l' 'u' 's' 'h') (spaces option)* Action36) / ('r' 'u' 'l' 'e' spaces ('a' 'd' 'd') (spaces ruleoption)* Action37) / ('r' 'u' 'l' 'e' spaces ('d' 'e' 'l') (spaces ruleoption)* Action38) / ('r' 'u' 'l' 'e' spaces ('s' 'h' 'o' 'w') (spaces rulefamily)? Action39) / ('r' 'u' 'l' 'e' spaces <.+> Action40 EOT) / ('l' 'i' 'n' 'k' spaces ('a' 'd' 'd') spaces ('v' 'e' 't' 'h') spaces vethend0 spaces ('p' 'e' 'e' 'r') spaces vethend1 (spaces vethaddress)? Action41) / ('l' 'i' 'n' 'k' spaces ('a' 'd' 'd') spaces linktype spaces linkname (spaces linkaddoption)* Action42) / ('l' 'i' 'n' 'k' spaces ('s' 'e' 't') spaces linkname (spaces linkoption)+ Action43) / ('l' 'i' 'n' 'k' spaces ('s' 'h' 'o' 'w') (spaces linkname)? Action44) / ('l' 'i' 'n' 'k' spaces ('a' 'd' 'o' 'p' 't') spaces linkname (spaces moveoption)* Action45) / ('l' 'i' 'n' 'k' spaces ('r' 'e' 'l' 'e' 'a' 's' 'e') spaces linkname (spaces moveoption)* Action46) / ('l' 'i' 'n' 'k' spaces <.+> Action47 EOT) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces ('a' 'd' 'd') spaces neighaddr (spaces neighoption)* Action48) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces ('d' 'e' 'l') spaces neighaddr (spaces neighoption)* Action49) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') spaces neighaddr (spaces neighoption)* Action50) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces ('s' 'h' 'o' 'w') (spaces neighoption)* Action51) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces ('f' 'l' 'u' 's' 'h') (spaces neighoption)* Action52) / ('n' 'e' 'i' 'g' 'h' 'b' 'o' 'r' spaces <.+> Action53 EOT) / ('v' 'r' 'f' spaces ('s' 'h' 'o' 'w') Action54) / ('q' 'd' 'i' 's' 'c' spaces ('a' 'd' 'd') (spaces qdiscoption)+ Action55) / ('q' 'd' 'i' 's' 'c' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') (spaces qdiscoption)+ Action56) / ('q' 'd' 'i' 's' 'c' spaces ('d' 'e' 'l') (spaces qdiscoption)+ Action57) / ('q' 'd' 'i' 's' 'c' spaces ('s' 'h' 'o' 'w') (spaces qdiscoption)* Action58) / ('q' 'd' 'i' 's' 'c' spaces <.+> Action59 EOT) / ('c' 'l' 'a' 's' 's' spaces ('a' 'd' 'd') (spaces qdiscoption)+ Action60) / ('c' 'l' 'a' 's' 's' spaces ('r' 'e' 'p' 'l' 'a' 'c' 'e') (spaces qdiscoption)+ Action61) / ('c' 'l' 'a' 's' 's' spaces ('d' 'e' 'l') (spaces qdiscoption)+ Action62) / ('c' 'l' 'a' 's' 's' spaces ('s' 'h' 'o' 'w') (spaces qdiscoption)* Action63) / ('c' 'l' 'a' 's' 's' spaces <.+> Action64 EOT) / ('n' 'a' 't' spaces ('m' 'a' 's' 'q' 'u' 'e' 'r' 'a' 'd' 'e') (spaces nftoption)* Action65) / ('n' 'a' 't' spaces ('s' 'n' 'a' 't') (spaces nftoption)* Action66) / ('n' 'a' 't' spaces ('d' 'n' 'a' 't') (spaces nftoption)* Action67) / ('n' 'a' 't' spaces ('s' 'h' 'o' 'w') Action68) / ('n' 'a' 't' spaces ('f' 'l' 'u' 's' 'h') Action69) / ('n' 'a' 't' spaces <.+> Action70 EOT) / ('f' 'i' 'l' 't' 'e' 'r' spaces ('s' 'h' 'o' 'w') Action71) / ('f' 'i' 'l' 't' 'e' 'r' spaces ('f' 'l' 'u' 's' 'h') Action72) / ('f' 'i' 'l' 't' 'e' 'r' spaces filterchain spaces filterverdict (spaces nftoption)* Action73) / ('f' 'i' 'l' 't' 'e' 'r' spaces <.+> Action74 EOT) / ('c' 'o' 'n' 'n' 't' 'r' 'a' 'c' 'k' spaces ('s' 'h' 'o' 'w') (spaces conntrackoption)* Action75) / ('c' 'o' 'n' 'n' 't' 'r' 'a' 'c' 'k' spaces ('f' 'l' 'u' 's' 'h') (spaces conntrackoption)* Action76) / ('c' 'o' 'n' 'n' 't' 'r' 'a' 'c' 'k' spaces <.+> Action77 EOT) / ('s' 'y' 's' 'c' 't' 'l' spaces ('g' 'e' 't') spaces sysctlkey Action78) / ('s' 'y' 's' 'c' 't' 'l' spaces ('s' 'e' 't') spaces sysctlkey spaces <((!' ' .)+ (spaces (!' ' .)+)*)> Action79 Action80) / ('s' 'y' 's' 'c' 't' 'l' spaces <.+> Action81 EOT) / ('v' 'r' 'f' spaces <.+> Action82 EOT) / )> */
+		func() bool {
+			{
+				position46 := position
+				{
+					position47, tokenIndex47 := position, tokenIndex
 					if buffer[position] != rune('r') {
 						goto l48
 					}
@@ -1015,15 +1893,15 @@ func (p *Parser) Init() {
 					if !_rules[rulespaces]() {
 						goto l48
 					}
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('a') {
 						goto l48
 					}
 					position++
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('d') {
 						goto l48
 					}
 					position++
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('d') {
 						goto l48
 					}
 					position++
@@ -1033,1117 +1911,13034 @@ func (p *Parser) Init() {
 					if !_rules[rulenetwork]() {
 						goto l48
 					}
-					if !_rules[rulespaces]() {
-						goto l48
-					}
+				l49:
 					{
-						position49 := position
-						if !matchDot() {
-							goto l48
+						position50, tokenIndex50 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l50
 						}
-					l50:
-						{
-							position51, tokenIndex51 := position, tokenIndex
-							if !matchDot() {
-								goto l51
-							}
+						if !_rules[ruleoption]() {
 							goto l50
-						l51:
-							position, tokenIndex = position51, tokenIndex51
 						}
-						add(rulePegText, position49)
-					}
-					if !_rules[ruleAction12]() {
-						goto l48
+						goto l49
+					l50:
+						position, tokenIndex = position50, tokenIndex50
 					}
-					if !_rules[ruleEOT]() {
+					if !_rules[ruleAction13]() {
 						goto l48
 					}
-					goto l33
+					goto l47
 				l48:
-					position, tokenIndex = position33, tokenIndex33
+					position, tokenIndex = position47, tokenIndex47
 					if buffer[position] != rune('r') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l52
+						goto l51
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l52
+						goto l51
 					}
 					if buffer[position] != rune('d') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l52
+						goto l51
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l52
+						goto l51
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l52
+						goto l51
+					}
+					if !_rules[rulenetwork]() {
+						goto l51
 					}
+				l52:
 					{
-						position53 := position
-						if !matchDot() {
-							goto l52
+						position53, tokenIndex53 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l53
 						}
-					l54:
-						{
-							position55, tokenIndex55 := position, tokenIndex
-							if !matchDot() {
-								goto l55
-							}
-							goto l54
-						l55:
-							position, tokenIndex = position55, tokenIndex55
+						if !_rules[ruleoption]() {
+							goto l53
 						}
-						add(rulePegText, position53)
-					}
-					if !_rules[ruleAction13]() {
 						goto l52
+					l53:
+						position, tokenIndex = position53, tokenIndex53
 					}
-					if !_rules[ruleEOT]() {
-						goto l52
+					if !_rules[ruleAction14]() {
+						goto l51
 					}
-					goto l33
-				l52:
-					position, tokenIndex = position33, tokenIndex33
+					goto l47
+				l51:
+					position, tokenIndex = position47, tokenIndex47
 					if buffer[position] != rune('r') {
-						goto l56
+						goto l54
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l56
+						goto l54
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l56
+						goto l54
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l56
+						goto l54
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l56
+						goto l54
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l56
-					}
-					{
-						position57 := position
-						if !matchDot() {
-							goto l56
-						}
-					l58:
-						{
-							position59, tokenIndex59 := position, tokenIndex
-							if !matchDot() {
-								goto l59
-							}
-							goto l58
-						l59:
-							position, tokenIndex = position59, tokenIndex59
-						}
-						add(rulePegText, position57)
-					}
-					if !_rules[ruleAction14]() {
-						goto l56
-					}
-					if !_rules[ruleEOT]() {
-						goto l56
-					}
-					goto l33
-				l56:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l60
-					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l60
+						goto l54
 					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l60
-					}
-					position++
 					if buffer[position] != rune('r') {
-						goto l60
+						goto l54
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l60
+						goto l54
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l60
+					if buffer[position] != rune('p') {
+						goto l54
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l60
+					if buffer[position] != rune('l') {
+						goto l54
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l60
-					}
 					if buffer[position] != rune('a') {
-						goto l60
+						goto l54
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l60
+					if buffer[position] != rune('c') {
+						goto l54
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l60
+					if buffer[position] != rune('e') {
+						goto l54
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l60
+						goto l54
 					}
 					if !_rules[rulenetwork]() {
-						goto l60
-					}
-					if !_rules[rulespaces]() {
-						goto l60
+						goto l54
 					}
-					if !_rules[ruleoption]() {
-						goto l60
+				l55:
+					{
+						position56, tokenIndex56 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l56
+						}
+						if !_rules[ruleoption]() {
+							goto l56
+						}
+						goto l55
+					l56:
+						position, tokenIndex = position56, tokenIndex56
 					}
 					if !_rules[ruleAction15]() {
-						goto l60
+						goto l54
 					}
-					goto l33
-				l60:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l61
+					goto l47
+				l54:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l61
+					if buffer[position] != rune('o') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l61
+					if buffer[position] != rune('u') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l61
+					if buffer[position] != rune('t') {
+						goto l57
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l61
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l61
+					if !_rules[rulespaces]() {
+						goto l57
+					}
+					if buffer[position] != rune('c') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l61
+					if buffer[position] != rune('h') {
+						goto l57
 					}
 					position++
-					if !_rules[rulespaces]() {
-						goto l61
+					if buffer[position] != rune('a') {
+						goto l57
 					}
-					if buffer[position] != rune('d') {
-						goto l61
+					position++
+					if buffer[position] != rune('n') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('e') {
-						goto l61
+					if buffer[position] != rune('g') {
+						goto l57
 					}
 					position++
-					if buffer[position] != rune('l') {
-						goto l61
+					if buffer[position] != rune('e') {
+						goto l57
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l61
+						goto l57
 					}
 					if !_rules[rulenetwork]() {
-						goto l61
-					}
-					if !_rules[rulespaces]() {
-						goto l61
+						goto l57
 					}
-					if !_rules[ruleoption]() {
-						goto l61
+				l58:
+					{
+						position59, tokenIndex59 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l59
+						}
+						if !_rules[ruleoption]() {
+							goto l59
+						}
+						goto l58
+					l59:
+						position, tokenIndex = position59, tokenIndex59
 					}
 					if !_rules[ruleAction16]() {
-						goto l61
+						goto l57
 					}
-					goto l33
-				l61:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l62
+					goto l47
+				l57:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l60
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l62
+					if buffer[position] != rune('o') {
+						goto l60
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l62
+					if buffer[position] != rune('u') {
+						goto l60
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l62
+					if buffer[position] != rune('t') {
+						goto l60
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l62
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l62
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l62
+						goto l60
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l62
+						goto l60
 					}
 					if buffer[position] != rune('a') {
-						goto l62
+						goto l60
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l62
+						goto l60
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l62
+						goto l60
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l62
+						goto l60
 					}
 					if !_rules[rulenetwork]() {
-						goto l62
+						goto l60
 					}
 					if !_rules[rulespaces]() {
-						goto l62
+						goto l60
 					}
 					{
-						position63 := position
+						position61 := position
 						if !matchDot() {
-							goto l62
+							goto l60
 						}
-					l64:
+					l62:
 						{
-							position65, tokenIndex65 := position, tokenIndex
+							position63, tokenIndex63 := position, tokenIndex
 							if !matchDot() {
-								goto l65
+								goto l63
 							}
-							goto l64
-						l65:
-							position, tokenIndex = position65, tokenIndex65
+							goto l62
+						l63:
+							position, tokenIndex = position63, tokenIndex63
 						}
-						add(rulePegText, position63)
+						add(rulePegText, position61)
 					}
 					if !_rules[ruleAction17]() {
-						goto l62
+						goto l60
 					}
 					if !_rules[ruleEOT]() {
-						goto l62
+						goto l60
 					}
-					goto l33
-				l62:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l66
+					goto l47
+				l60:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l64
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l66
+					if buffer[position] != rune('o') {
+						goto l64
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l66
+					if buffer[position] != rune('u') {
+						goto l64
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l66
+					if buffer[position] != rune('t') {
+						goto l64
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l66
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l66
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l66
+						goto l64
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l66
+						goto l64
 					}
 					if buffer[position] != rune('a') {
-						goto l66
+						goto l64
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l66
+						goto l64
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l66
+						goto l64
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l66
+						goto l64
 					}
 					{
-						position67 := position
+						position65 := position
 						if !matchDot() {
-							goto l66
+							goto l64
 						}
-					l68:
+					l66:
 						{
-							position69, tokenIndex69 := position, tokenIndex
+							position67, tokenIndex67 := position, tokenIndex
 							if !matchDot() {
-								goto l69
+								goto l67
 							}
-							goto l68
-						l69:
-							position, tokenIndex = position69, tokenIndex69
+							goto l66
+						l67:
+							position, tokenIndex = position67, tokenIndex67
 						}
-						add(rulePegText, position67)
+						add(rulePegText, position65)
 					}
 					if !_rules[ruleAction18]() {
-						goto l66
+						goto l64
 					}
 					if !_rules[ruleEOT]() {
-						goto l66
+						goto l64
 					}
-					goto l33
-				l66:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l70
+					goto l47
+				l64:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l68
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l70
+					if buffer[position] != rune('o') {
+						goto l68
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l70
+					if buffer[position] != rune('u') {
+						goto l68
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l70
+					if buffer[position] != rune('t') {
+						goto l68
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l70
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l70
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l70
+						goto l68
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l70
+						goto l68
 					}
 					if buffer[position] != rune('d') {
-						goto l70
+						goto l68
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l70
+						goto l68
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l70
+						goto l68
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l70
+						goto l68
 					}
 					if !_rules[rulenetwork]() {
-						goto l70
+						goto l68
 					}
 					if !_rules[rulespaces]() {
-						goto l70
+						goto l68
 					}
 					{
-						position71 := position
+						position69 := position
 						if !matchDot() {
-							goto l70
+							goto l68
 						}
-					l72:
+					l70:
 						{
-							position73, tokenIndex73 := position, tokenIndex
+							position71, tokenIndex71 := position, tokenIndex
 							if !matchDot() {
-								goto l73
+								goto l71
 							}
-							goto l72
-						l73:
-							position, tokenIndex = position73, tokenIndex73
+							goto l70
+						l71:
+							position, tokenIndex = position71, tokenIndex71
 						}
-						add(rulePegText, position71)
+						add(rulePegText, position69)
 					}
 					if !_rules[ruleAction19]() {
-						goto l70
+						goto l68
 					}
 					if !_rules[ruleEOT]() {
-						goto l70
+						goto l68
 					}
-					goto l33
-				l70:
-					position, tokenIndex = position33, tokenIndex33
-					if buffer[position] != rune('a') {
-						goto l74
+					goto l47
+				l68:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l72
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l74
+					if buffer[position] != rune('o') {
+						goto l72
 					}
 					position++
-					if buffer[position] != rune('d') {
-						goto l74
+					if buffer[position] != rune('u') {
+						goto l72
 					}
 					position++
-					if buffer[position] != rune('r') {
-						goto l74
+					if buffer[position] != rune('t') {
+						goto l72
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l74
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l74
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l74
+						goto l72
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l74
+						goto l72
 					}
 					if buffer[position] != rune('d') {
-						goto l74
+						goto l72
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l74
+						goto l72
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l74
+						goto l72
 					}
 					position++
 					if !_rules[rulespaces]() {
-						goto l74
+						goto l72
 					}
 					{
-						position75 := position
+						position73 := position
 						if !matchDot() {
-							goto l74
+							goto l72
 						}
-					l76:
+					l74:
 						{
-							position77, tokenIndex77 := position, tokenIndex
+							position75, tokenIndex75 := position, tokenIndex
 							if !matchDot() {
-								goto l77
+								goto l75
 							}
-							goto l76
-						l77:
-							position, tokenIndex = position77, tokenIndex77
+							goto l74
+						l75:
+							position, tokenIndex = position75, tokenIndex75
 						}
-						add(rulePegText, position75)
+						add(rulePegText, position73)
 					}
 					if !_rules[ruleAction20]() {
-						goto l74
+						goto l72
 					}
 					if !_rules[ruleEOT]() {
-						goto l74
-					}
-					goto l33
-				l74:
-					position, tokenIndex = position33, tokenIndex33
-				}
-			l33:
-				add(ruleoperation, position32)
-			}
-			return true
-		},
-		/* 5 network <- <((addrstr '/' len Action21) / ('d' 'e' 'f' 'a' 'u' 'l' 't' Action22))> */
-		func() bool {
-			position78, tokenIndex78 := position, tokenIndex
-			{
-				position79 := position
-				{
-					position80, tokenIndex80 := position, tokenIndex
-					if !_rules[ruleaddrstr]() {
-						goto l81
+						goto l72
 					}
-					if buffer[position] != rune('/') {
-						goto l81
+					goto l47
+				l72:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l76
 					}
 					position++
-					if !_rules[rulelen]() {
-						goto l81
+					if buffer[position] != rune('o') {
+						goto l76
 					}
-					if !_rules[ruleAction21]() {
-						goto l81
+					position++
+					if buffer[position] != rune('u') {
+						goto l76
 					}
-					goto l80
-				l81:
-					position, tokenIndex = position80, tokenIndex80
-					if buffer[position] != rune('d') {
-						goto l78
+					position++
+					if buffer[position] != rune('t') {
+						goto l76
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l78
+						goto l76
 					}
 					position++
-					if buffer[position] != rune('f') {
-						goto l78
+					if !_rules[rulespaces]() {
+						goto l76
+					}
+					if buffer[position] != rune('r') {
+						goto l76
 					}
 					position++
-					if buffer[position] != rune('a') {
-						goto l78
+					if buffer[position] != rune('e') {
+						goto l76
 					}
 					position++
-					if buffer[position] != rune('u') {
-						goto l78
+					if buffer[position] != rune('p') {
+						goto l76
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l78
+						goto l76
 					}
 					position++
-					if buffer[position] != rune('t') {
-						goto l78
+					if buffer[position] != rune('a') {
+						goto l76
 					}
 					position++
-					if !_rules[ruleAction22]() {
-						goto l78
+					if buffer[position] != rune('c') {
+						goto l76
 					}
-				}
-			l80:
-				add(rulenetwork, position79)
-			}
-			return true
-		l78:
-			position, tokenIndex = position78, tokenIndex78
-			return false
-		},
-		/* 6 addrstr <- <(<([0-9] / [a-f] / [A-F] / ':' / '.')+> Action23)> */
-		func() bool {
-			position82, tokenIndex82 := position, tokenIndex
-			{
-				position83 := position
-				{
-					position84 := position
-					{
-						position87, tokenIndex87 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l88
-						}
-						position++
-						goto l87
-					l88:
-						position, tokenIndex = position87, tokenIndex87
-						if c := buffer[position]; c < rune('a') || c > rune('f') {
-							goto l89
-						}
-						position++
-						goto l87
-					l89:
-						position, tokenIndex = position87, tokenIndex87
-						if c := buffer[position]; c < rune('A') || c > rune('F') {
-							goto l90
-						}
-						position++
-						goto l87
-					l90:
-						position, tokenIndex = position87, tokenIndex87
-						if buffer[position] != rune(':') {
-							goto l91
-						}
-						position++
-						goto l87
-					l91:
-						position, tokenIndex = position87, tokenIndex87
-						if buffer[position] != rune('.') {
-							goto l82
-						}
-						position++
+					position++
+					if buffer[position] != rune('e') {
+						goto l76
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l76
+					}
+					if !_rules[rulenetwork]() {
+						goto l76
+					}
+					if !_rules[rulespaces]() {
+						goto l76
 					}
-				l87:
-				l85:
 					{
-						position86, tokenIndex86 := position, tokenIndex
+						position77 := position
+						if !matchDot() {
+							goto l76
+						}
+					l78:
 						{
-							position92, tokenIndex92 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l93
+							position79, tokenIndex79 := position, tokenIndex
+							if !matchDot() {
+								goto l79
 							}
-							position++
-							goto l92
-						l93:
-							position, tokenIndex = position92, tokenIndex92
-							if c := buffer[position]; c < rune('a') || c > rune('f') {
-								goto l94
-							}
-							position++
-							goto l92
-						l94:
-							position, tokenIndex = position92, tokenIndex92
-							if c := buffer[position]; c < rune('A') || c > rune('F') {
-								goto l95
+							goto l78
+						l79:
+							position, tokenIndex = position79, tokenIndex79
+						}
+						add(rulePegText, position77)
+					}
+					if !_rules[ruleAction21]() {
+						goto l76
+					}
+					if !_rules[ruleEOT]() {
+						goto l76
+					}
+					goto l47
+				l76:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l80
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l80
+					}
+					if buffer[position] != rune('r') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l80
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l80
+					}
+					{
+						position81 := position
+						if !matchDot() {
+							goto l80
+						}
+					l82:
+						{
+							position83, tokenIndex83 := position, tokenIndex
+							if !matchDot() {
+								goto l83
 							}
-							position++
-							goto l92
-						l95:
-							position, tokenIndex = position92, tokenIndex92
-							if buffer[position] != rune(':') {
-								goto l96
+							goto l82
+						l83:
+							position, tokenIndex = position83, tokenIndex83
+						}
+						add(rulePegText, position81)
+					}
+					if !_rules[ruleAction22]() {
+						goto l80
+					}
+					if !_rules[ruleEOT]() {
+						goto l80
+					}
+					goto l47
+				l80:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l84
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l84
+					}
+					if buffer[position] != rune('c') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l84
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l84
+					}
+					if !_rules[rulenetwork]() {
+						goto l84
+					}
+					if !_rules[rulespaces]() {
+						goto l84
+					}
+					{
+						position85 := position
+						if !matchDot() {
+							goto l84
+						}
+					l86:
+						{
+							position87, tokenIndex87 := position, tokenIndex
+							if !matchDot() {
+								goto l87
 							}
-							position++
-							goto l92
-						l96:
-							position, tokenIndex = position92, tokenIndex92
-							if buffer[position] != rune('.') {
-								goto l86
+							goto l86
+						l87:
+							position, tokenIndex = position87, tokenIndex87
+						}
+						add(rulePegText, position85)
+					}
+					if !_rules[ruleAction23]() {
+						goto l84
+					}
+					if !_rules[ruleEOT]() {
+						goto l84
+					}
+					goto l47
+				l84:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l88
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l88
+					}
+					if buffer[position] != rune('c') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l88
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l88
+					}
+					{
+						position89 := position
+						if !matchDot() {
+							goto l88
+						}
+					l90:
+						{
+							position91, tokenIndex91 := position, tokenIndex
+							if !matchDot() {
+								goto l91
 							}
-							position++
+							goto l90
+						l91:
+							position, tokenIndex = position91, tokenIndex91
 						}
-					l92:
-						goto l85
-					l86:
-						position, tokenIndex = position86, tokenIndex86
+						add(rulePegText, position89)
 					}
-					add(rulePegText, position84)
-				}
-				if !_rules[ruleAction23]() {
-					goto l82
-				}
-				add(ruleaddrstr, position83)
+					if !_rules[ruleAction24]() {
+						goto l88
+					}
+					if !_rules[ruleEOT]() {
+						goto l88
+					}
+					goto l47
+				l88:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l92
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l92
+					}
+					if buffer[position] != rune('f') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l92
+					}
+					position++
+					{
+						position93, tokenIndex93 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l93
+						}
+						if !_rules[ruleroutefamily]() {
+							goto l93
+						}
+						goto l94
+					l93:
+						position, tokenIndex = position93, tokenIndex93
+					}
+				l94:
+				l95:
+					{
+						position96, tokenIndex96 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l96
+						}
+						if !_rules[ruleoption]() {
+							goto l96
+						}
+						goto l95
+					l96:
+						position, tokenIndex = position96, tokenIndex96
+					}
+					if !_rules[ruleAction25]() {
+						goto l92
+					}
+					goto l47
+				l92:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l97
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l97
+					}
+					if buffer[position] != rune('s') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l97
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l97
+					}
+					position++
+				l98:
+					{
+						position99, tokenIndex99 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l99
+						}
+						if !_rules[ruleoption]() {
+							goto l99
+						}
+						goto l98
+					l99:
+						position, tokenIndex = position99, tokenIndex99
+					}
+					if !_rules[ruleAction26]() {
+						goto l97
+					}
+					goto l47
+				l97:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l100
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l100
+					}
+					if buffer[position] != rune('g') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l100
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l100
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l100
+					}
+					{
+						position101 := position
+						{
+							position104, tokenIndex104 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l104
+							}
+							position++
+							goto l100
+						l104:
+							position, tokenIndex = position104, tokenIndex104
+						}
+						if !matchDot() {
+							goto l100
+						}
+					l102:
+						{
+							position103, tokenIndex103 := position, tokenIndex
+							{
+								position105, tokenIndex105 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l105
+								}
+								position++
+								goto l103
+							l105:
+								position, tokenIndex = position105, tokenIndex105
+							}
+							if !matchDot() {
+								goto l103
+							}
+							goto l102
+						l103:
+							position, tokenIndex = position103, tokenIndex103
+						}
+						add(rulePegText, position101)
+					}
+					if !_rules[ruleAction27]() {
+						goto l100
+					}
+				l106:
+					{
+						position107, tokenIndex107 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l107
+						}
+						if !_rules[ruleroutegetoption]() {
+							goto l107
+						}
+						goto l106
+					l107:
+						position, tokenIndex = position107, tokenIndex107
+					}
+					if !_rules[ruleAction28]() {
+						goto l100
+					}
+					goto l47
+				l100:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l108
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l108
+					}
+					{
+						position109 := position
+						if !matchDot() {
+							goto l108
+						}
+					l110:
+						{
+							position111, tokenIndex111 := position, tokenIndex
+							if !matchDot() {
+								goto l111
+							}
+							goto l110
+						l111:
+							position, tokenIndex = position111, tokenIndex111
+						}
+						add(rulePegText, position109)
+					}
+					if !_rules[ruleAction29]() {
+						goto l108
+					}
+					if !_rules[ruleEOT]() {
+						goto l108
+					}
+					goto l47
+				l108:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l112
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l112
+					}
+					if buffer[position] != rune('a') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l112
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l112
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l112
+					}
+					if !_rules[ruleaddrprefix]() {
+						goto l112
+					}
+				l113:
+					{
+						position114, tokenIndex114 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l114
+						}
+						if !_rules[ruleaddroption]() {
+							goto l114
+						}
+						goto l113
+					l114:
+						position, tokenIndex = position114, tokenIndex114
+					}
+					if !_rules[ruleAction30]() {
+						goto l112
+					}
+					goto l47
+				l112:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l115
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l115
+					}
+					if buffer[position] != rune('d') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l115
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l115
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l115
+					}
+					if !_rules[ruleaddrprefix]() {
+						goto l115
+					}
+				l116:
+					{
+						position117, tokenIndex117 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l117
+						}
+						if !_rules[ruleaddroption]() {
+							goto l117
+						}
+						goto l116
+					l117:
+						position, tokenIndex = position117, tokenIndex117
+					}
+					if !_rules[ruleAction31]() {
+						goto l115
+					}
+					goto l47
+				l115:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l118
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l118
+					}
+					if buffer[position] != rune('a') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l118
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l118
+					}
+					if !_rules[ruleaddrprefix]() {
+						goto l118
+					}
+					if !_rules[rulespaces]() {
+						goto l118
+					}
+					{
+						position119 := position
+						if !matchDot() {
+							goto l118
+						}
+					l120:
+						{
+							position121, tokenIndex121 := position, tokenIndex
+							if !matchDot() {
+								goto l121
+							}
+							goto l120
+						l121:
+							position, tokenIndex = position121, tokenIndex121
+						}
+						add(rulePegText, position119)
+					}
+					if !_rules[ruleAction32]() {
+						goto l118
+					}
+					if !_rules[ruleEOT]() {
+						goto l118
+					}
+					goto l47
+				l118:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l122
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l122
+					}
+					if buffer[position] != rune('a') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l122
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l122
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l122
+					}
+					{
+						position123 := position
+						if !matchDot() {
+							goto l122
+						}
+					l124:
+						{
+							position125, tokenIndex125 := position, tokenIndex
+							if !matchDot() {
+								goto l125
+							}
+							goto l124
+						l125:
+							position, tokenIndex = position125, tokenIndex125
+						}
+						add(rulePegText, position123)
+					}
+					if !_rules[ruleAction33]() {
+						goto l122
+					}
+					if !_rules[ruleEOT]() {
+						goto l122
+					}
+					goto l47
+				l122:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l126
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l126
+					}
+					if buffer[position] != rune('d') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l126
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l126
+					}
+					if !_rules[ruleaddrprefix]() {
+						goto l126
+					}
+					if !_rules[rulespaces]() {
+						goto l126
+					}
+					{
+						position127 := position
+						if !matchDot() {
+							goto l126
+						}
+					l128:
+						{
+							position129, tokenIndex129 := position, tokenIndex
+							if !matchDot() {
+								goto l129
+							}
+							goto l128
+						l129:
+							position, tokenIndex = position129, tokenIndex129
+						}
+						add(rulePegText, position127)
+					}
+					if !_rules[ruleAction34]() {
+						goto l126
+					}
+					if !_rules[ruleEOT]() {
+						goto l126
+					}
+					goto l47
+				l126:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l130
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l130
+					}
+					if buffer[position] != rune('d') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l130
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l130
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l130
+					}
+					{
+						position131 := position
+						if !matchDot() {
+							goto l130
+						}
+					l132:
+						{
+							position133, tokenIndex133 := position, tokenIndex
+							if !matchDot() {
+								goto l133
+							}
+							goto l132
+						l133:
+							position, tokenIndex = position133, tokenIndex133
+						}
+						add(rulePegText, position131)
+					}
+					if !_rules[ruleAction35]() {
+						goto l130
+					}
+					if !_rules[ruleEOT]() {
+						goto l130
+					}
+					goto l47
+				l130:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('a') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l134
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l134
+					}
+					if buffer[position] != rune('f') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l134
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l134
+					}
+					position++
+				l135:
+					{
+						position136, tokenIndex136 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l136
+						}
+						if !_rules[ruleoption]() {
+							goto l136
+						}
+						goto l135
+					l136:
+						position, tokenIndex = position136, tokenIndex136
+					}
+					if !_rules[ruleAction36]() {
+						goto l134
+					}
+					goto l47
+				l134:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l137
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l137
+					}
+					if buffer[position] != rune('a') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l137
+					}
+					position++
+				l138:
+					{
+						position139, tokenIndex139 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l139
+						}
+						if !_rules[ruleruleoption]() {
+							goto l139
+						}
+						goto l138
+					l139:
+						position, tokenIndex = position139, tokenIndex139
+					}
+					if !_rules[ruleAction37]() {
+						goto l137
+					}
+					goto l47
+				l137:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l140
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l140
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l140
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l140
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l140
+					}
+					if buffer[position] != rune('d') {
+						goto l140
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l140
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l140
+					}
+					position++
+				l141:
+					{
+						position142, tokenIndex142 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l142
+						}
+						if !_rules[ruleruleoption]() {
+							goto l142
+						}
+						goto l141
+					l142:
+						position, tokenIndex = position142, tokenIndex142
+					}
+					if !_rules[ruleAction38]() {
+						goto l140
+					}
+					goto l47
+				l140:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l143
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l143
+					}
+					if buffer[position] != rune('s') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l143
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l143
+					}
+					position++
+					{
+						position144, tokenIndex144 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l144
+						}
+						if !_rules[rulerulefamily]() {
+							goto l144
+						}
+						goto l145
+					l144:
+						position, tokenIndex = position144, tokenIndex144
+					}
+				l145:
+					if !_rules[ruleAction39]() {
+						goto l143
+					}
+					goto l47
+				l143:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('r') {
+						goto l146
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l146
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l146
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l146
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l146
+					}
+					{
+						position147 := position
+						if !matchDot() {
+							goto l146
+						}
+					l148:
+						{
+							position149, tokenIndex149 := position, tokenIndex
+							if !matchDot() {
+								goto l149
+							}
+							goto l148
+						l149:
+							position, tokenIndex = position149, tokenIndex149
+						}
+						add(rulePegText, position147)
+					}
+					if !_rules[ruleAction40]() {
+						goto l146
+					}
+					if !_rules[ruleEOT]() {
+						goto l146
+					}
+					goto l47
+				l146:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l150
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l150
+					}
+					if buffer[position] != rune('a') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l150
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l150
+					}
+					if buffer[position] != rune('v') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l150
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l150
+					}
+					if !_rules[rulevethend0]() {
+						goto l150
+					}
+					if !_rules[rulespaces]() {
+						goto l150
+					}
+					if buffer[position] != rune('p') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l150
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l150
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l150
+					}
+					if !_rules[rulevethend1]() {
+						goto l150
+					}
+					{
+						position151, tokenIndex151 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l151
+						}
+						if !_rules[rulevethaddress]() {
+							goto l151
+						}
+						goto l152
+					l151:
+						position, tokenIndex = position151, tokenIndex151
+					}
+				l152:
+					if !_rules[ruleAction41]() {
+						goto l150
+					}
+					goto l47
+				l150:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l153
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l153
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l153
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l153
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l153
+					}
+					if buffer[position] != rune('a') {
+						goto l153
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l153
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l153
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l153
+					}
+					if !_rules[rulelinktype]() {
+						goto l153
+					}
+					if !_rules[rulespaces]() {
+						goto l153
+					}
+					if !_rules[rulelinkname]() {
+						goto l153
+					}
+				l154:
+					{
+						position155, tokenIndex155 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l155
+						}
+						if !_rules[rulelinkaddoption]() {
+							goto l155
+						}
+						goto l154
+					l155:
+						position, tokenIndex = position155, tokenIndex155
+					}
+					if !_rules[ruleAction42]() {
+						goto l153
+					}
+					goto l47
+				l153:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l156
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l156
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l156
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l156
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l156
+					}
+					if buffer[position] != rune('s') {
+						goto l156
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l156
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l156
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l156
+					}
+					if !_rules[rulelinkname]() {
+						goto l156
+					}
+					if !_rules[rulespaces]() {
+						goto l156
+					}
+					if !_rules[rulelinkoption]() {
+						goto l156
+					}
+				l157:
+					{
+						position158, tokenIndex158 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l158
+						}
+						if !_rules[rulelinkoption]() {
+							goto l158
+						}
+						goto l157
+					l158:
+						position, tokenIndex = position158, tokenIndex158
+					}
+					if !_rules[ruleAction43]() {
+						goto l156
+					}
+					goto l47
+				l156:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l159
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l159
+					}
+					if buffer[position] != rune('s') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l159
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l159
+					}
+					position++
+					{
+						position160, tokenIndex160 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l160
+						}
+						if !_rules[rulelinkname]() {
+							goto l160
+						}
+						goto l161
+					l160:
+						position, tokenIndex = position160, tokenIndex160
+					}
+				l161:
+					if !_rules[ruleAction44]() {
+						goto l159
+					}
+					goto l47
+				l159:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l162
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l162
+					}
+					if buffer[position] != rune('a') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l162
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l162
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l162
+					}
+					if !_rules[rulelinkname]() {
+						goto l162
+					}
+				l163:
+					{
+						position164, tokenIndex164 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l164
+						}
+						if !_rules[rulemoveoption]() {
+							goto l164
+						}
+						goto l163
+					l164:
+						position, tokenIndex = position164, tokenIndex164
+					}
+					if !_rules[ruleAction45]() {
+						goto l162
+					}
+					goto l47
+				l162:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l165
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l165
+					}
+					if buffer[position] != rune('r') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l165
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l165
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l165
+					}
+					if !_rules[rulelinkname]() {
+						goto l165
+					}
+				l166:
+					{
+						position167, tokenIndex167 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l167
+						}
+						if !_rules[rulemoveoption]() {
+							goto l167
+						}
+						goto l166
+					l167:
+						position, tokenIndex = position167, tokenIndex167
+					}
+					if !_rules[ruleAction46]() {
+						goto l165
+					}
+					goto l47
+				l165:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('l') {
+						goto l168
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l168
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l168
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l168
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l168
+					}
+					{
+						position169 := position
+						if !matchDot() {
+							goto l168
+						}
+					l170:
+						{
+							position171, tokenIndex171 := position, tokenIndex
+							if !matchDot() {
+								goto l171
+							}
+							goto l170
+						l171:
+							position, tokenIndex = position171, tokenIndex171
+						}
+						add(rulePegText, position169)
+					}
+					if !_rules[ruleAction47]() {
+						goto l168
+					}
+					if !_rules[ruleEOT]() {
+						goto l168
+					}
+					goto l47
+				l168:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l172
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l172
+					}
+					if buffer[position] != rune('a') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l172
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l172
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l172
+					}
+					if !_rules[ruleneighaddr]() {
+						goto l172
+					}
+				l173:
+					{
+						position174, tokenIndex174 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l174
+						}
+						if !_rules[ruleneighoption]() {
+							goto l174
+						}
+						goto l173
+					l174:
+						position, tokenIndex = position174, tokenIndex174
+					}
+					if !_rules[ruleAction48]() {
+						goto l172
+					}
+					goto l47
+				l172:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l175
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l175
+					}
+					if buffer[position] != rune('d') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l175
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l175
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l175
+					}
+					if !_rules[ruleneighaddr]() {
+						goto l175
+					}
+				l176:
+					{
+						position177, tokenIndex177 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l177
+						}
+						if !_rules[ruleneighoption]() {
+							goto l177
+						}
+						goto l176
+					l177:
+						position, tokenIndex = position177, tokenIndex177
+					}
+					if !_rules[ruleAction49]() {
+						goto l175
+					}
+					goto l47
+				l175:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l178
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l178
+					}
+					if buffer[position] != rune('r') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l178
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l178
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l178
+					}
+					if !_rules[ruleneighaddr]() {
+						goto l178
+					}
+				l179:
+					{
+						position180, tokenIndex180 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l180
+						}
+						if !_rules[ruleneighoption]() {
+							goto l180
+						}
+						goto l179
+					l180:
+						position, tokenIndex = position180, tokenIndex180
+					}
+					if !_rules[ruleAction50]() {
+						goto l178
+					}
+					goto l47
+				l178:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l181
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l181
+					}
+					if buffer[position] != rune('s') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l181
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l181
+					}
+					position++
+				l182:
+					{
+						position183, tokenIndex183 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l183
+						}
+						if !_rules[ruleneighoption]() {
+							goto l183
+						}
+						goto l182
+					l183:
+						position, tokenIndex = position183, tokenIndex183
+					}
+					if !_rules[ruleAction51]() {
+						goto l181
+					}
+					goto l47
+				l181:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l184
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l184
+					}
+					if buffer[position] != rune('f') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l184
+					}
+					position++
+				l185:
+					{
+						position186, tokenIndex186 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l186
+						}
+						if !_rules[ruleneighoption]() {
+							goto l186
+						}
+						goto l185
+					l186:
+						position, tokenIndex = position186, tokenIndex186
+					}
+					if !_rules[ruleAction52]() {
+						goto l184
+					}
+					goto l47
+				l184:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l187
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l187
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l187
+					}
+					{
+						position188 := position
+						if !matchDot() {
+							goto l187
+						}
+					l189:
+						{
+							position190, tokenIndex190 := position, tokenIndex
+							if !matchDot() {
+								goto l190
+							}
+							goto l189
+						l190:
+							position, tokenIndex = position190, tokenIndex190
+						}
+						add(rulePegText, position188)
+					}
+					if !_rules[ruleAction53]() {
+						goto l187
+					}
+					if !_rules[ruleEOT]() {
+						goto l187
+					}
+					goto l47
+				l187:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('v') {
+						goto l191
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l191
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l191
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l191
+					}
+					if buffer[position] != rune('s') {
+						goto l191
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l191
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l191
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l191
+					}
+					position++
+					if !_rules[ruleAction54]() {
+						goto l191
+					}
+					goto l47
+				l191:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('q') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l192
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l192
+					}
+					if buffer[position] != rune('a') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l192
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l192
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l192
+					}
+				l193:
+					{
+						position194, tokenIndex194 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l194
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l194
+						}
+						goto l193
+					l194:
+						position, tokenIndex = position194, tokenIndex194
+					}
+					if !_rules[ruleAction55]() {
+						goto l192
+					}
+					goto l47
+				l192:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('q') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l195
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l195
+					}
+					if buffer[position] != rune('r') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l195
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l195
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l195
+					}
+				l196:
+					{
+						position197, tokenIndex197 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l197
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l197
+						}
+						goto l196
+					l197:
+						position, tokenIndex = position197, tokenIndex197
+					}
+					if !_rules[ruleAction56]() {
+						goto l195
+					}
+					goto l47
+				l195:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('q') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l198
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l198
+					}
+					if buffer[position] != rune('d') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l198
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l198
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l198
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l198
+					}
+				l199:
+					{
+						position200, tokenIndex200 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l200
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l200
+						}
+						goto l199
+					l200:
+						position, tokenIndex = position200, tokenIndex200
+					}
+					if !_rules[ruleAction57]() {
+						goto l198
+					}
+					goto l47
+				l198:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('q') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l201
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l201
+					}
+					if buffer[position] != rune('s') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l201
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l201
+					}
+					position++
+				l202:
+					{
+						position203, tokenIndex203 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l203
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l203
+						}
+						goto l202
+					l203:
+						position, tokenIndex = position203, tokenIndex203
+					}
+					if !_rules[ruleAction58]() {
+						goto l201
+					}
+					goto l47
+				l201:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('q') {
+						goto l204
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l204
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l204
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l204
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l204
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l204
+					}
+					{
+						position205 := position
+						if !matchDot() {
+							goto l204
+						}
+					l206:
+						{
+							position207, tokenIndex207 := position, tokenIndex
+							if !matchDot() {
+								goto l207
+							}
+							goto l206
+						l207:
+							position, tokenIndex = position207, tokenIndex207
+						}
+						add(rulePegText, position205)
+					}
+					if !_rules[ruleAction59]() {
+						goto l204
+					}
+					if !_rules[ruleEOT]() {
+						goto l204
+					}
+					goto l47
+				l204:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l208
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l208
+					}
+					if buffer[position] != rune('a') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l208
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l208
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l208
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l208
+					}
+				l209:
+					{
+						position210, tokenIndex210 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l210
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l210
+						}
+						goto l209
+					l210:
+						position, tokenIndex = position210, tokenIndex210
+					}
+					if !_rules[ruleAction60]() {
+						goto l208
+					}
+					goto l47
+				l208:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l211
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l211
+					}
+					if buffer[position] != rune('r') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l211
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l211
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l211
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l211
+					}
+				l212:
+					{
+						position213, tokenIndex213 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l213
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l213
+						}
+						goto l212
+					l213:
+						position, tokenIndex = position213, tokenIndex213
+					}
+					if !_rules[ruleAction61]() {
+						goto l211
+					}
+					goto l47
+				l211:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l214
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l214
+					}
+					if buffer[position] != rune('d') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l214
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l214
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l214
+					}
+					if !_rules[ruleqdiscoption]() {
+						goto l214
+					}
+				l215:
+					{
+						position216, tokenIndex216 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l216
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l216
+						}
+						goto l215
+					l216:
+						position, tokenIndex = position216, tokenIndex216
+					}
+					if !_rules[ruleAction62]() {
+						goto l214
+					}
+					goto l47
+				l214:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l217
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l217
+					}
+					if buffer[position] != rune('s') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l217
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l217
+					}
+					position++
+				l218:
+					{
+						position219, tokenIndex219 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l219
+						}
+						if !_rules[ruleqdiscoption]() {
+							goto l219
+						}
+						goto l218
+					l219:
+						position, tokenIndex = position219, tokenIndex219
+					}
+					if !_rules[ruleAction63]() {
+						goto l217
+					}
+					goto l47
+				l217:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l220
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l220
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l220
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l220
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l220
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l220
+					}
+					{
+						position221 := position
+						if !matchDot() {
+							goto l220
+						}
+					l222:
+						{
+							position223, tokenIndex223 := position, tokenIndex
+							if !matchDot() {
+								goto l223
+							}
+							goto l222
+						l223:
+							position, tokenIndex = position223, tokenIndex223
+						}
+						add(rulePegText, position221)
+					}
+					if !_rules[ruleAction64]() {
+						goto l220
+					}
+					if !_rules[ruleEOT]() {
+						goto l220
+					}
+					goto l47
+				l220:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l224
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l224
+					}
+					if buffer[position] != rune('m') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('q') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l224
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l224
+					}
+					position++
+				l225:
+					{
+						position226, tokenIndex226 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l226
+						}
+						if !_rules[rulenftoption]() {
+							goto l226
+						}
+						goto l225
+					l226:
+						position, tokenIndex = position226, tokenIndex226
+					}
+					if !_rules[ruleAction65]() {
+						goto l224
+					}
+					goto l47
+				l224:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l227
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l227
+					}
+					if buffer[position] != rune('s') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l227
+					}
+					position++
+				l228:
+					{
+						position229, tokenIndex229 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l229
+						}
+						if !_rules[rulenftoption]() {
+							goto l229
+						}
+						goto l228
+					l229:
+						position, tokenIndex = position229, tokenIndex229
+					}
+					if !_rules[ruleAction66]() {
+						goto l227
+					}
+					goto l47
+				l227:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l230
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l230
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l230
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l230
+					}
+					if buffer[position] != rune('d') {
+						goto l230
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l230
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l230
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l230
+					}
+					position++
+				l231:
+					{
+						position232, tokenIndex232 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l232
+						}
+						if !_rules[rulenftoption]() {
+							goto l232
+						}
+						goto l231
+					l232:
+						position, tokenIndex = position232, tokenIndex232
+					}
+					if !_rules[ruleAction67]() {
+						goto l230
+					}
+					goto l47
+				l230:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l233
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l233
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l233
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l233
+					}
+					if buffer[position] != rune('s') {
+						goto l233
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l233
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l233
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l233
+					}
+					position++
+					if !_rules[ruleAction68]() {
+						goto l233
+					}
+					goto l47
+				l233:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l234
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l234
+					}
+					if buffer[position] != rune('f') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l234
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l234
+					}
+					position++
+					if !_rules[ruleAction69]() {
+						goto l234
+					}
+					goto l47
+				l234:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('n') {
+						goto l235
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l235
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l235
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l235
+					}
+					{
+						position236 := position
+						if !matchDot() {
+							goto l235
+						}
+					l237:
+						{
+							position238, tokenIndex238 := position, tokenIndex
+							if !matchDot() {
+								goto l238
+							}
+							goto l237
+						l238:
+							position, tokenIndex = position238, tokenIndex238
+						}
+						add(rulePegText, position236)
+					}
+					if !_rules[ruleAction70]() {
+						goto l235
+					}
+					if !_rules[ruleEOT]() {
+						goto l235
+					}
+					goto l47
+				l235:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('f') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l239
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l239
+					}
+					if buffer[position] != rune('s') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l239
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l239
+					}
+					position++
+					if !_rules[ruleAction71]() {
+						goto l239
+					}
+					goto l47
+				l239:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('f') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l240
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l240
+					}
+					if buffer[position] != rune('f') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l240
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l240
+					}
+					position++
+					if !_rules[ruleAction72]() {
+						goto l240
+					}
+					goto l47
+				l240:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('f') {
+						goto l241
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l241
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l241
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l241
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l241
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l241
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l241
+					}
+					if !_rules[rulefilterchain]() {
+						goto l241
+					}
+					if !_rules[rulespaces]() {
+						goto l241
+					}
+					if !_rules[rulefilterverdict]() {
+						goto l241
+					}
+				l242:
+					{
+						position243, tokenIndex243 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l243
+						}
+						if !_rules[rulenftoption]() {
+							goto l243
+						}
+						goto l242
+					l243:
+						position, tokenIndex = position243, tokenIndex243
+					}
+					if !_rules[ruleAction73]() {
+						goto l241
+					}
+					goto l47
+				l241:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('f') {
+						goto l244
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l244
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l244
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l244
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l244
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l244
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l244
+					}
+					{
+						position245 := position
+						if !matchDot() {
+							goto l244
+						}
+					l246:
+						{
+							position247, tokenIndex247 := position, tokenIndex
+							if !matchDot() {
+								goto l247
+							}
+							goto l246
+						l247:
+							position, tokenIndex = position247, tokenIndex247
+						}
+						add(rulePegText, position245)
+					}
+					if !_rules[ruleAction74]() {
+						goto l244
+					}
+					if !_rules[ruleEOT]() {
+						goto l244
+					}
+					goto l47
+				l244:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l248
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l248
+					}
+					if buffer[position] != rune('s') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l248
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l248
+					}
+					position++
+				l249:
+					{
+						position250, tokenIndex250 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l250
+						}
+						if !_rules[ruleconntrackoption]() {
+							goto l250
+						}
+						goto l249
+					l250:
+						position, tokenIndex = position250, tokenIndex250
+					}
+					if !_rules[ruleAction75]() {
+						goto l248
+					}
+					goto l47
+				l248:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l251
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l251
+					}
+					if buffer[position] != rune('f') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l251
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l251
+					}
+					position++
+				l252:
+					{
+						position253, tokenIndex253 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l253
+						}
+						if !_rules[ruleconntrackoption]() {
+							goto l253
+						}
+						goto l252
+					l253:
+						position, tokenIndex = position253, tokenIndex253
+					}
+					if !_rules[ruleAction76]() {
+						goto l251
+					}
+					goto l47
+				l251:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('c') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l254
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l254
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l254
+					}
+					{
+						position255 := position
+						if !matchDot() {
+							goto l254
+						}
+					l256:
+						{
+							position257, tokenIndex257 := position, tokenIndex
+							if !matchDot() {
+								goto l257
+							}
+							goto l256
+						l257:
+							position, tokenIndex = position257, tokenIndex257
+						}
+						add(rulePegText, position255)
+					}
+					if !_rules[ruleAction77]() {
+						goto l254
+					}
+					if !_rules[ruleEOT]() {
+						goto l254
+					}
+					goto l47
+				l254:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('s') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l258
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l258
+					}
+					if buffer[position] != rune('g') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l258
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l258
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l258
+					}
+					if !_rules[rulesysctlkey]() {
+						goto l258
+					}
+					if !_rules[ruleAction78]() {
+						goto l258
+					}
+					goto l47
+				l258:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('s') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l259
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l259
+					}
+					if buffer[position] != rune('s') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l259
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l259
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l259
+					}
+					if !_rules[rulesysctlkey]() {
+						goto l259
+					}
+					if !_rules[rulespaces]() {
+						goto l259
+					}
+					{
+						position260 := position
+						{
+							position263, tokenIndex263 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l263
+							}
+							position++
+							goto l259
+						l263:
+							position, tokenIndex = position263, tokenIndex263
+						}
+						if !matchDot() {
+							goto l259
+						}
+					l261:
+						{
+							position262, tokenIndex262 := position, tokenIndex
+							{
+								position264, tokenIndex264 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l264
+								}
+								position++
+								goto l262
+							l264:
+								position, tokenIndex = position264, tokenIndex264
+							}
+							if !matchDot() {
+								goto l262
+							}
+							goto l261
+						l262:
+							position, tokenIndex = position262, tokenIndex262
+						}
+					l265:
+						{
+							position266, tokenIndex266 := position, tokenIndex
+							if !_rules[rulespaces]() {
+								goto l266
+							}
+							{
+								position269, tokenIndex269 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l269
+								}
+								position++
+								goto l266
+							l269:
+								position, tokenIndex = position269, tokenIndex269
+							}
+							if !matchDot() {
+								goto l266
+							}
+						l267:
+							{
+								position268, tokenIndex268 := position, tokenIndex
+								{
+									position270, tokenIndex270 := position, tokenIndex
+									if buffer[position] != rune(' ') {
+										goto l270
+									}
+									position++
+									goto l268
+								l270:
+									position, tokenIndex = position270, tokenIndex270
+								}
+								if !matchDot() {
+									goto l268
+								}
+								goto l267
+							l268:
+								position, tokenIndex = position268, tokenIndex268
+							}
+							goto l265
+						l266:
+							position, tokenIndex = position266, tokenIndex266
+						}
+						add(rulePegText, position260)
+					}
+					if !_rules[ruleAction79]() {
+						goto l259
+					}
+					if !_rules[ruleAction80]() {
+						goto l259
+					}
+					goto l47
+				l259:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('s') {
+						goto l271
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l271
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l271
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l271
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l271
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l271
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l271
+					}
+					{
+						position272 := position
+						if !matchDot() {
+							goto l271
+						}
+					l273:
+						{
+							position274, tokenIndex274 := position, tokenIndex
+							if !matchDot() {
+								goto l274
+							}
+							goto l273
+						l274:
+							position, tokenIndex = position274, tokenIndex274
+						}
+						add(rulePegText, position272)
+					}
+					if !_rules[ruleAction81]() {
+						goto l271
+					}
+					if !_rules[ruleEOT]() {
+						goto l271
+					}
+					goto l47
+				l271:
+					position, tokenIndex = position47, tokenIndex47
+					if buffer[position] != rune('v') {
+						goto l275
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l275
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l275
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l275
+					}
+					{
+						position276 := position
+						if !matchDot() {
+							goto l275
+						}
+					l277:
+						{
+							position278, tokenIndex278 := position, tokenIndex
+							if !matchDot() {
+								goto l278
+							}
+							goto l277
+						l278:
+							position, tokenIndex = position278, tokenIndex278
+						}
+						add(rulePegText, position276)
+					}
+					if !_rules[ruleAction82]() {
+						goto l275
+					}
+					if !_rules[ruleEOT]() {
+						goto l275
+					}
+					goto l47
+				l275:
+					position, tokenIndex = position47, tokenIndex47
+				}
+			l47:
+				add(ruleoperation, position46)
+			}
+			return true
+		},
+		/* 7 network <- <(('m' 'p' 'l' 's' spaces <[0-9]+> Action83) / ('d' 'e' 'f' 'a' 'u' 'l' 't' Action84) / (addrstr ('/' len)? Action85))> */
+		func() bool {
+			position279, tokenIndex279 := position, tokenIndex
+			{
+				position280 := position
+				{
+					position281, tokenIndex281 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l282
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l282
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l282
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l282
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l282
+					}
+					{
+						position283 := position
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l282
+						}
+						position++
+					l284:
+						{
+							position285, tokenIndex285 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l285
+							}
+							position++
+							goto l284
+						l285:
+							position, tokenIndex = position285, tokenIndex285
+						}
+						add(rulePegText, position283)
+					}
+					if !_rules[ruleAction83]() {
+						goto l282
+					}
+					goto l281
+				l282:
+					position, tokenIndex = position281, tokenIndex281
+					if buffer[position] != rune('d') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l286
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l286
+					}
+					position++
+					if !_rules[ruleAction84]() {
+						goto l286
+					}
+					goto l281
+				l286:
+					position, tokenIndex = position281, tokenIndex281
+					if !_rules[ruleaddrstr]() {
+						goto l279
+					}
+					{
+						position287, tokenIndex287 := position, tokenIndex
+						if buffer[position] != rune('/') {
+							goto l287
+						}
+						position++
+						if !_rules[rulelen]() {
+							goto l287
+						}
+						goto l288
+					l287:
+						position, tokenIndex = position287, tokenIndex287
+					}
+				l288:
+					if !_rules[ruleAction85]() {
+						goto l279
+					}
+				}
+			l281:
+				add(rulenetwork, position280)
+			}
+			return true
+		l279:
+			position, tokenIndex = position279, tokenIndex279
+			return false
+		},
+		/* 8 addrprefix <- <(addrstr '/' len Action86)> */
+		func() bool {
+			position289, tokenIndex289 := position, tokenIndex
+			{
+				position290 := position
+				if !_rules[ruleaddrstr]() {
+					goto l289
+				}
+				if buffer[position] != rune('/') {
+					goto l289
+				}
+				position++
+				if !_rules[rulelen]() {
+					goto l289
+				}
+				if !_rules[ruleAction86]() {
+					goto l289
+				}
+				add(ruleaddrprefix, position290)
+			}
+			return true
+		l289:
+			position, tokenIndex = position289, tokenIndex289
+			return false
+		},
+		/* 9 addrstr <- <(<([0-9] / [a-f] / [A-F] / ':' / '.')+> Action87)> */
+		func() bool {
+			position291, tokenIndex291 := position, tokenIndex
+			{
+				position292 := position
+				{
+					position293 := position
+					{
+						position296, tokenIndex296 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l297
+						}
+						position++
+						goto l296
+					l297:
+						position, tokenIndex = position296, tokenIndex296
+						if c := buffer[position]; c < rune('a') || c > rune('f') {
+							goto l298
+						}
+						position++
+						goto l296
+					l298:
+						position, tokenIndex = position296, tokenIndex296
+						if c := buffer[position]; c < rune('A') || c > rune('F') {
+							goto l299
+						}
+						position++
+						goto l296
+					l299:
+						position, tokenIndex = position296, tokenIndex296
+						if buffer[position] != rune(':') {
+							goto l300
+						}
+						position++
+						goto l296
+					l300:
+						position, tokenIndex = position296, tokenIndex296
+						if buffer[position] != rune('.') {
+							goto l291
+						}
+						position++
+					}
+				l296:
+				l294:
+					{
+						position295, tokenIndex295 := position, tokenIndex
+						{
+							position301, tokenIndex301 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l302
+							}
+							position++
+							goto l301
+						l302:
+							position, tokenIndex = position301, tokenIndex301
+							if c := buffer[position]; c < rune('a') || c > rune('f') {
+								goto l303
+							}
+							position++
+							goto l301
+						l303:
+							position, tokenIndex = position301, tokenIndex301
+							if c := buffer[position]; c < rune('A') || c > rune('F') {
+								goto l304
+							}
+							position++
+							goto l301
+						l304:
+							position, tokenIndex = position301, tokenIndex301
+							if buffer[position] != rune(':') {
+								goto l305
+							}
+							position++
+							goto l301
+						l305:
+							position, tokenIndex = position301, tokenIndex301
+							if buffer[position] != rune('.') {
+								goto l295
+							}
+							position++
+						}
+					l301:
+						goto l294
+					l295:
+						position, tokenIndex = position295, tokenIndex295
+					}
+					add(rulePegText, position293)
+				}
+				if !_rules[ruleAction87]() {
+					goto l291
+				}
+				add(ruleaddrstr, position292)
+			}
+			return true
+		l291:
+			position, tokenIndex = position291, tokenIndex291
+			return false
+		},
+		/* 10 len <- <(<[0-9]+> Action88)> */
+		func() bool {
+			position306, tokenIndex306 := position, tokenIndex
+			{
+				position307 := position
+				{
+					position308 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l306
+					}
+					position++
+				l309:
+					{
+						position310, tokenIndex310 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l310
+						}
+						position++
+						goto l309
+					l310:
+						position, tokenIndex = position310, tokenIndex310
+					}
+					add(rulePegText, position308)
+				}
+				if !_rules[ruleAction88]() {
+					goto l306
+				}
+				add(rulelen, position307)
+			}
+			return true
+		l306:
+			position, tokenIndex = position306, tokenIndex306
+			return false
+		},
+		/* 11 option <- <(('v' 'i' 'a' spaces ('i' 'n' 'e' 't' '6') spaces <(!' ' .)+> Action89) / ('v' 'i' 'a' spaces <(!' ' .)+> Action90) / ('d' 'e' 'v' spaces <(!' ' .)+> Action91) / ('t' 'a' 'b' 'l' 'e' spaces <(!' ' .)+> Action92) / ('p' 'r' 'o' 't' 'o' spaces <(!' ' .)+> Action93) / ('s' 'c' 'o' 'p' 'e' spaces <(!' ' .)+> Action94) / ('v' 'r' 'f' spaces <(!' ' .)+> Action95) / ('o' 'n' 'l' 'i' 'n' 'k' Action96) / ('p' 'r' 'e' 'f' spaces <(!' ' .)+> Action97) / ('e' 'x' 'p' 'i' 'r' 'e' 's' spaces <(!' ' .)+> Action98) / ('a' 's' spaces <(!' ' .)+> Action99) / ('e' 'n' 'c' 'a' 'p' spaces encap))> */
+		func() bool {
+			position311, tokenIndex311 := position, tokenIndex
+			{
+				position312 := position
+				{
+					position313, tokenIndex313 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l314
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l314
+					}
+					if buffer[position] != rune('i') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l314
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l314
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l314
+					}
+					{
+						position315 := position
+						{
+							position318, tokenIndex318 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l318
+							}
+							position++
+							goto l314
+						l318:
+							position, tokenIndex = position318, tokenIndex318
+						}
+						if !matchDot() {
+							goto l314
+						}
+					l316:
+						{
+							position317, tokenIndex317 := position, tokenIndex
+							{
+								position319, tokenIndex319 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l319
+								}
+								position++
+								goto l317
+							l319:
+								position, tokenIndex = position319, tokenIndex319
+							}
+							if !matchDot() {
+								goto l317
+							}
+							goto l316
+						l317:
+							position, tokenIndex = position317, tokenIndex317
+						}
+						add(rulePegText, position315)
+					}
+					if !_rules[ruleAction89]() {
+						goto l314
+					}
+					goto l313
+				l314:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('v') {
+						goto l320
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l320
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l320
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l320
+					}
+					{
+						position321 := position
+						{
+							position324, tokenIndex324 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l324
+							}
+							position++
+							goto l320
+						l324:
+							position, tokenIndex = position324, tokenIndex324
+						}
+						if !matchDot() {
+							goto l320
+						}
+					l322:
+						{
+							position323, tokenIndex323 := position, tokenIndex
+							{
+								position325, tokenIndex325 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l325
+								}
+								position++
+								goto l323
+							l325:
+								position, tokenIndex = position325, tokenIndex325
+							}
+							if !matchDot() {
+								goto l323
+							}
+							goto l322
+						l323:
+							position, tokenIndex = position323, tokenIndex323
+						}
+						add(rulePegText, position321)
+					}
+					if !_rules[ruleAction90]() {
+						goto l320
+					}
+					goto l313
+				l320:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('d') {
+						goto l326
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l326
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l326
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l326
+					}
+					{
+						position327 := position
+						{
+							position330, tokenIndex330 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l330
+							}
+							position++
+							goto l326
+						l330:
+							position, tokenIndex = position330, tokenIndex330
+						}
+						if !matchDot() {
+							goto l326
+						}
+					l328:
+						{
+							position329, tokenIndex329 := position, tokenIndex
+							{
+								position331, tokenIndex331 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l331
+								}
+								position++
+								goto l329
+							l331:
+								position, tokenIndex = position331, tokenIndex331
+							}
+							if !matchDot() {
+								goto l329
+							}
+							goto l328
+						l329:
+							position, tokenIndex = position329, tokenIndex329
+						}
+						add(rulePegText, position327)
+					}
+					if !_rules[ruleAction91]() {
+						goto l326
+					}
+					goto l313
+				l326:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('t') {
+						goto l332
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l332
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l332
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l332
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l332
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l332
+					}
+					{
+						position333 := position
+						{
+							position336, tokenIndex336 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l336
+							}
+							position++
+							goto l332
+						l336:
+							position, tokenIndex = position336, tokenIndex336
+						}
+						if !matchDot() {
+							goto l332
+						}
+					l334:
+						{
+							position335, tokenIndex335 := position, tokenIndex
+							{
+								position337, tokenIndex337 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l337
+								}
+								position++
+								goto l335
+							l337:
+								position, tokenIndex = position337, tokenIndex337
+							}
+							if !matchDot() {
+								goto l335
+							}
+							goto l334
+						l335:
+							position, tokenIndex = position335, tokenIndex335
+						}
+						add(rulePegText, position333)
+					}
+					if !_rules[ruleAction92]() {
+						goto l332
+					}
+					goto l313
+				l332:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('p') {
+						goto l338
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l338
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l338
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l338
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l338
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l338
+					}
+					{
+						position339 := position
+						{
+							position342, tokenIndex342 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l342
+							}
+							position++
+							goto l338
+						l342:
+							position, tokenIndex = position342, tokenIndex342
+						}
+						if !matchDot() {
+							goto l338
+						}
+					l340:
+						{
+							position341, tokenIndex341 := position, tokenIndex
+							{
+								position343, tokenIndex343 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l343
+								}
+								position++
+								goto l341
+							l343:
+								position, tokenIndex = position343, tokenIndex343
+							}
+							if !matchDot() {
+								goto l341
+							}
+							goto l340
+						l341:
+							position, tokenIndex = position341, tokenIndex341
+						}
+						add(rulePegText, position339)
+					}
+					if !_rules[ruleAction93]() {
+						goto l338
+					}
+					goto l313
+				l338:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('s') {
+						goto l344
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l344
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l344
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l344
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l344
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l344
+					}
+					{
+						position345 := position
+						{
+							position348, tokenIndex348 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l348
+							}
+							position++
+							goto l344
+						l348:
+							position, tokenIndex = position348, tokenIndex348
+						}
+						if !matchDot() {
+							goto l344
+						}
+					l346:
+						{
+							position347, tokenIndex347 := position, tokenIndex
+							{
+								position349, tokenIndex349 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l349
+								}
+								position++
+								goto l347
+							l349:
+								position, tokenIndex = position349, tokenIndex349
+							}
+							if !matchDot() {
+								goto l347
+							}
+							goto l346
+						l347:
+							position, tokenIndex = position347, tokenIndex347
+						}
+						add(rulePegText, position345)
+					}
+					if !_rules[ruleAction94]() {
+						goto l344
+					}
+					goto l313
+				l344:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('v') {
+						goto l350
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l350
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l350
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l350
+					}
+					{
+						position351 := position
+						{
+							position354, tokenIndex354 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l354
+							}
+							position++
+							goto l350
+						l354:
+							position, tokenIndex = position354, tokenIndex354
+						}
+						if !matchDot() {
+							goto l350
+						}
+					l352:
+						{
+							position353, tokenIndex353 := position, tokenIndex
+							{
+								position355, tokenIndex355 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l355
+								}
+								position++
+								goto l353
+							l355:
+								position, tokenIndex = position355, tokenIndex355
+							}
+							if !matchDot() {
+								goto l353
+							}
+							goto l352
+						l353:
+							position, tokenIndex = position353, tokenIndex353
+						}
+						add(rulePegText, position351)
+					}
+					if !_rules[ruleAction95]() {
+						goto l350
+					}
+					goto l313
+				l350:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('o') {
+						goto l356
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l356
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l356
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l356
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l356
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l356
+					}
+					position++
+					if !_rules[ruleAction96]() {
+						goto l356
+					}
+					goto l313
+				l356:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('p') {
+						goto l357
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l357
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l357
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l357
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l357
+					}
+					{
+						position358 := position
+						{
+							position361, tokenIndex361 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l361
+							}
+							position++
+							goto l357
+						l361:
+							position, tokenIndex = position361, tokenIndex361
+						}
+						if !matchDot() {
+							goto l357
+						}
+					l359:
+						{
+							position360, tokenIndex360 := position, tokenIndex
+							{
+								position362, tokenIndex362 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l362
+								}
+								position++
+								goto l360
+							l362:
+								position, tokenIndex = position362, tokenIndex362
+							}
+							if !matchDot() {
+								goto l360
+							}
+							goto l359
+						l360:
+							position, tokenIndex = position360, tokenIndex360
+						}
+						add(rulePegText, position358)
+					}
+					if !_rules[ruleAction97]() {
+						goto l357
+					}
+					goto l313
+				l357:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('e') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l363
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l363
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l363
+					}
+					{
+						position364 := position
+						{
+							position367, tokenIndex367 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l367
+							}
+							position++
+							goto l363
+						l367:
+							position, tokenIndex = position367, tokenIndex367
+						}
+						if !matchDot() {
+							goto l363
+						}
+					l365:
+						{
+							position366, tokenIndex366 := position, tokenIndex
+							{
+								position368, tokenIndex368 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l368
+								}
+								position++
+								goto l366
+							l368:
+								position, tokenIndex = position368, tokenIndex368
+							}
+							if !matchDot() {
+								goto l366
+							}
+							goto l365
+						l366:
+							position, tokenIndex = position366, tokenIndex366
+						}
+						add(rulePegText, position364)
+					}
+					if !_rules[ruleAction98]() {
+						goto l363
+					}
+					goto l313
+				l363:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('a') {
+						goto l369
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l369
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l369
+					}
+					{
+						position370 := position
+						{
+							position373, tokenIndex373 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l373
+							}
+							position++
+							goto l369
+						l373:
+							position, tokenIndex = position373, tokenIndex373
+						}
+						if !matchDot() {
+							goto l369
+						}
+					l371:
+						{
+							position372, tokenIndex372 := position, tokenIndex
+							{
+								position374, tokenIndex374 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l374
+								}
+								position++
+								goto l372
+							l374:
+								position, tokenIndex = position374, tokenIndex374
+							}
+							if !matchDot() {
+								goto l372
+							}
+							goto l371
+						l372:
+							position, tokenIndex = position372, tokenIndex372
+						}
+						add(rulePegText, position370)
+					}
+					if !_rules[ruleAction99]() {
+						goto l369
+					}
+					goto l313
+				l369:
+					position, tokenIndex = position313, tokenIndex313
+					if buffer[position] != rune('e') {
+						goto l311
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l311
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l311
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l311
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l311
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l311
+					}
+					if !_rules[ruleencap]() {
+						goto l311
+					}
+				}
+			l313:
+				add(ruleoption, position312)
+			}
+			return true
+		l311:
+			position, tokenIndex = position311, tokenIndex311
+			return false
+		},
+		/* 12 encap <- <(('m' 'p' 'l' 's' spaces <(!' ' .)+> Action100) / ('s' 'e' 'g' '6' 'l' 'o' 'c' 'a' 'l' spaces ('a' 'c' 't' 'i' 'o' 'n') spaces <(!' ' .)+> Action101 (spaces seg6localoption)*) / ('s' 'e' 'g' '6' spaces ('m' 'o' 'd' 'e') spaces <(!' ' .)+> Action102 spaces ('s' 'e' 'g' 's') spaces <(!' ' .)+> Action103))> */
+		func() bool {
+			position375, tokenIndex375 := position, tokenIndex
+			{
+				position376 := position
+				{
+					position377, tokenIndex377 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l378
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l378
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l378
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l378
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l378
+					}
+					{
+						position379 := position
+						{
+							position382, tokenIndex382 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l382
+							}
+							position++
+							goto l378
+						l382:
+							position, tokenIndex = position382, tokenIndex382
+						}
+						if !matchDot() {
+							goto l378
+						}
+					l380:
+						{
+							position381, tokenIndex381 := position, tokenIndex
+							{
+								position383, tokenIndex383 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l383
+								}
+								position++
+								goto l381
+							l383:
+								position, tokenIndex = position383, tokenIndex383
+							}
+							if !matchDot() {
+								goto l381
+							}
+							goto l380
+						l381:
+							position, tokenIndex = position381, tokenIndex381
+						}
+						add(rulePegText, position379)
+					}
+					if !_rules[ruleAction100]() {
+						goto l378
+					}
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
+					if buffer[position] != rune('s') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l384
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l384
+					}
+					if buffer[position] != rune('a') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l384
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l384
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l384
+					}
+					{
+						position385 := position
+						{
+							position388, tokenIndex388 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l388
+							}
+							position++
+							goto l384
+						l388:
+							position, tokenIndex = position388, tokenIndex388
+						}
+						if !matchDot() {
+							goto l384
+						}
+					l386:
+						{
+							position387, tokenIndex387 := position, tokenIndex
+							{
+								position389, tokenIndex389 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l389
+								}
+								position++
+								goto l387
+							l389:
+								position, tokenIndex = position389, tokenIndex389
+							}
+							if !matchDot() {
+								goto l387
+							}
+							goto l386
+						l387:
+							position, tokenIndex = position387, tokenIndex387
+						}
+						add(rulePegText, position385)
+					}
+					if !_rules[ruleAction101]() {
+						goto l384
+					}
+				l390:
+					{
+						position391, tokenIndex391 := position, tokenIndex
+						if !_rules[rulespaces]() {
+							goto l391
+						}
+						if !_rules[ruleseg6localoption]() {
+							goto l391
+						}
+						goto l390
+					l391:
+						position, tokenIndex = position391, tokenIndex391
+					}
+					goto l377
+				l384:
+					position, tokenIndex = position377, tokenIndex377
+					if buffer[position] != rune('s') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l375
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l375
+					}
+					if buffer[position] != rune('m') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l375
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l375
+					}
+					{
+						position392 := position
+						{
+							position395, tokenIndex395 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l395
+							}
+							position++
+							goto l375
+						l395:
+							position, tokenIndex = position395, tokenIndex395
+						}
+						if !matchDot() {
+							goto l375
+						}
+					l393:
+						{
+							position394, tokenIndex394 := position, tokenIndex
+							{
+								position396, tokenIndex396 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l396
+								}
+								position++
+								goto l394
+							l396:
+								position, tokenIndex = position396, tokenIndex396
+							}
+							if !matchDot() {
+								goto l394
+							}
+							goto l393
+						l394:
+							position, tokenIndex = position394, tokenIndex394
+						}
+						add(rulePegText, position392)
+					}
+					if !_rules[ruleAction102]() {
+						goto l375
+					}
+					if !_rules[rulespaces]() {
+						goto l375
+					}
+					if buffer[position] != rune('s') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l375
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l375
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l375
+					}
+					{
+						position397 := position
+						{
+							position400, tokenIndex400 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l400
+							}
+							position++
+							goto l375
+						l400:
+							position, tokenIndex = position400, tokenIndex400
+						}
+						if !matchDot() {
+							goto l375
+						}
+					l398:
+						{
+							position399, tokenIndex399 := position, tokenIndex
+							{
+								position401, tokenIndex401 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l401
+								}
+								position++
+								goto l399
+							l401:
+								position, tokenIndex = position401, tokenIndex401
+							}
+							if !matchDot() {
+								goto l399
+							}
+							goto l398
+						l399:
+							position, tokenIndex = position399, tokenIndex399
+						}
+						add(rulePegText, position397)
+					}
+					if !_rules[ruleAction103]() {
+						goto l375
+					}
+				}
+			l377:
+				add(ruleencap, position376)
+			}
+			return true
+		l375:
+			position, tokenIndex = position375, tokenIndex375
+			return false
+		},
+		/* 13 seg6localoption <- <(('n' 'h' '6' spaces <(!' ' .)+> Action104) / ('t' 'a' 'b' 'l' 'e' spaces <(!' ' .)+> Action105) / ('v' 'r' 'f' 't' 'a' 'b' 'l' 'e' spaces <(!' ' .)+> Action106))> */
+		func() bool {
+			position402, tokenIndex402 := position, tokenIndex
+			{
+				position403 := position
+				{
+					position404, tokenIndex404 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l405
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l405
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l405
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l405
+					}
+					{
+						position406 := position
+						{
+							position409, tokenIndex409 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l409
+							}
+							position++
+							goto l405
+						l409:
+							position, tokenIndex = position409, tokenIndex409
+						}
+						if !matchDot() {
+							goto l405
+						}
+					l407:
+						{
+							position408, tokenIndex408 := position, tokenIndex
+							{
+								position410, tokenIndex410 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l410
+								}
+								position++
+								goto l408
+							l410:
+								position, tokenIndex = position410, tokenIndex410
+							}
+							if !matchDot() {
+								goto l408
+							}
+							goto l407
+						l408:
+							position, tokenIndex = position408, tokenIndex408
+						}
+						add(rulePegText, position406)
+					}
+					if !_rules[ruleAction104]() {
+						goto l405
+					}
+					goto l404
+				l405:
+					position, tokenIndex = position404, tokenIndex404
+					if buffer[position] != rune('t') {
+						goto l411
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l411
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l411
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l411
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l411
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l411
+					}
+					{
+						position412 := position
+						{
+							position415, tokenIndex415 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l415
+							}
+							position++
+							goto l411
+						l415:
+							position, tokenIndex = position415, tokenIndex415
+						}
+						if !matchDot() {
+							goto l411
+						}
+					l413:
+						{
+							position414, tokenIndex414 := position, tokenIndex
+							{
+								position416, tokenIndex416 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l416
+								}
+								position++
+								goto l414
+							l416:
+								position, tokenIndex = position416, tokenIndex416
+							}
+							if !matchDot() {
+								goto l414
+							}
+							goto l413
+						l414:
+							position, tokenIndex = position414, tokenIndex414
+						}
+						add(rulePegText, position412)
+					}
+					if !_rules[ruleAction105]() {
+						goto l411
+					}
+					goto l404
+				l411:
+					position, tokenIndex = position404, tokenIndex404
+					if buffer[position] != rune('v') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l402
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l402
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l402
+					}
+					{
+						position417 := position
+						{
+							position420, tokenIndex420 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l420
+							}
+							position++
+							goto l402
+						l420:
+							position, tokenIndex = position420, tokenIndex420
+						}
+						if !matchDot() {
+							goto l402
+						}
+					l418:
+						{
+							position419, tokenIndex419 := position, tokenIndex
+							{
+								position421, tokenIndex421 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l421
+								}
+								position++
+								goto l419
+							l421:
+								position, tokenIndex = position421, tokenIndex421
+							}
+							if !matchDot() {
+								goto l419
+							}
+							goto l418
+						l419:
+							position, tokenIndex = position419, tokenIndex419
+						}
+						add(rulePegText, position417)
+					}
+					if !_rules[ruleAction106]() {
+						goto l402
+					}
+				}
+			l404:
+				add(ruleseg6localoption, position403)
+			}
+			return true
+		l402:
+			position, tokenIndex = position402, tokenIndex402
+			return false
+		},
+		/* 14 addroption <- <(('d' 'e' 'v' spaces <(!' ' .)+> Action107) / ('p' 'e' 'e' 'r' spaces <(!' ' .)+> Action108) / ('b' 'r' 'o' 'a' 'd' 'c' 'a' 's' 't' spaces <(!' ' .)+> Action109) / ('l' 'a' 'b' 'e' 'l' spaces <(!' ' .)+> Action110) / ('s' 'c' 'o' 'p' 'e' spaces <(!' ' .)+> Action111) / ('v' 'a' 'l' 'i' 'd' '_' 'l' 'f' 't' spaces <(!' ' .)+> Action112) / ('p' 'r' 'e' 'f' 'e' 'r' 'r' 'e' 'd' '_' 'l' 'f' 't' spaces <(!' ' .)+> Action113) / ('n' 'o' 'd' 'a' 'd' Action114) / ('n' 'o' 'p' 'r' 'e' 'f' 'i' 'x' 'r' 'o' 'u' 't' 'e' Action115) / ('h' 'o' 'm' 'e' Action116) / ('m' 'n' 'g' 't' 'm' 'p' 'a' 'd' 'd' 'r' Action117))> */
+		func() bool {
+			position422, tokenIndex422 := position, tokenIndex
+			{
+				position423 := position
+				{
+					position424, tokenIndex424 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l425
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l425
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l425
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l425
+					}
+					{
+						position426 := position
+						{
+							position429, tokenIndex429 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l429
+							}
+							position++
+							goto l425
+						l429:
+							position, tokenIndex = position429, tokenIndex429
+						}
+						if !matchDot() {
+							goto l425
+						}
+					l427:
+						{
+							position428, tokenIndex428 := position, tokenIndex
+							{
+								position430, tokenIndex430 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l430
+								}
+								position++
+								goto l428
+							l430:
+								position, tokenIndex = position430, tokenIndex430
+							}
+							if !matchDot() {
+								goto l428
+							}
+							goto l427
+						l428:
+							position, tokenIndex = position428, tokenIndex428
+						}
+						add(rulePegText, position426)
+					}
+					if !_rules[ruleAction107]() {
+						goto l425
+					}
+					goto l424
+				l425:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('p') {
+						goto l431
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l431
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l431
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l431
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l431
+					}
+					{
+						position432 := position
+						{
+							position435, tokenIndex435 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l435
+							}
+							position++
+							goto l431
+						l435:
+							position, tokenIndex = position435, tokenIndex435
+						}
+						if !matchDot() {
+							goto l431
+						}
+					l433:
+						{
+							position434, tokenIndex434 := position, tokenIndex
+							{
+								position436, tokenIndex436 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l436
+								}
+								position++
+								goto l434
+							l436:
+								position, tokenIndex = position436, tokenIndex436
+							}
+							if !matchDot() {
+								goto l434
+							}
+							goto l433
+						l434:
+							position, tokenIndex = position434, tokenIndex434
+						}
+						add(rulePegText, position432)
+					}
+					if !_rules[ruleAction108]() {
+						goto l431
+					}
+					goto l424
+				l431:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('b') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l437
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l437
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l437
+					}
+					{
+						position438 := position
+						{
+							position441, tokenIndex441 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l441
+							}
+							position++
+							goto l437
+						l441:
+							position, tokenIndex = position441, tokenIndex441
+						}
+						if !matchDot() {
+							goto l437
+						}
+					l439:
+						{
+							position440, tokenIndex440 := position, tokenIndex
+							{
+								position442, tokenIndex442 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l442
+								}
+								position++
+								goto l440
+							l442:
+								position, tokenIndex = position442, tokenIndex442
+							}
+							if !matchDot() {
+								goto l440
+							}
+							goto l439
+						l440:
+							position, tokenIndex = position440, tokenIndex440
+						}
+						add(rulePegText, position438)
+					}
+					if !_rules[ruleAction109]() {
+						goto l437
+					}
+					goto l424
+				l437:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('l') {
+						goto l443
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l443
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l443
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l443
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l443
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l443
+					}
+					{
+						position444 := position
+						{
+							position447, tokenIndex447 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l447
+							}
+							position++
+							goto l443
+						l447:
+							position, tokenIndex = position447, tokenIndex447
+						}
+						if !matchDot() {
+							goto l443
+						}
+					l445:
+						{
+							position446, tokenIndex446 := position, tokenIndex
+							{
+								position448, tokenIndex448 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l448
+								}
+								position++
+								goto l446
+							l448:
+								position, tokenIndex = position448, tokenIndex448
+							}
+							if !matchDot() {
+								goto l446
+							}
+							goto l445
+						l446:
+							position, tokenIndex = position446, tokenIndex446
+						}
+						add(rulePegText, position444)
+					}
+					if !_rules[ruleAction110]() {
+						goto l443
+					}
+					goto l424
+				l443:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('s') {
+						goto l449
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l449
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l449
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l449
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l449
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l449
+					}
+					{
+						position450 := position
+						{
+							position453, tokenIndex453 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l453
+							}
+							position++
+							goto l449
+						l453:
+							position, tokenIndex = position453, tokenIndex453
+						}
+						if !matchDot() {
+							goto l449
+						}
+					l451:
+						{
+							position452, tokenIndex452 := position, tokenIndex
+							{
+								position454, tokenIndex454 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l454
+								}
+								position++
+								goto l452
+							l454:
+								position, tokenIndex = position454, tokenIndex454
+							}
+							if !matchDot() {
+								goto l452
+							}
+							goto l451
+						l452:
+							position, tokenIndex = position452, tokenIndex452
+						}
+						add(rulePegText, position450)
+					}
+					if !_rules[ruleAction111]() {
+						goto l449
+					}
+					goto l424
+				l449:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('v') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l455
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l455
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l455
+					}
+					{
+						position456 := position
+						{
+							position459, tokenIndex459 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l459
+							}
+							position++
+							goto l455
+						l459:
+							position, tokenIndex = position459, tokenIndex459
+						}
+						if !matchDot() {
+							goto l455
+						}
+					l457:
+						{
+							position458, tokenIndex458 := position, tokenIndex
+							{
+								position460, tokenIndex460 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l460
+								}
+								position++
+								goto l458
+							l460:
+								position, tokenIndex = position460, tokenIndex460
+							}
+							if !matchDot() {
+								goto l458
+							}
+							goto l457
+						l458:
+							position, tokenIndex = position458, tokenIndex458
+						}
+						add(rulePegText, position456)
+					}
+					if !_rules[ruleAction112]() {
+						goto l455
+					}
+					goto l424
+				l455:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('p') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l461
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l461
+					}
+					{
+						position462 := position
+						{
+							position465, tokenIndex465 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l465
+							}
+							position++
+							goto l461
+						l465:
+							position, tokenIndex = position465, tokenIndex465
+						}
+						if !matchDot() {
+							goto l461
+						}
+					l463:
+						{
+							position464, tokenIndex464 := position, tokenIndex
+							{
+								position466, tokenIndex466 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l466
+								}
+								position++
+								goto l464
+							l466:
+								position, tokenIndex = position466, tokenIndex466
+							}
+							if !matchDot() {
+								goto l464
+							}
+							goto l463
+						l464:
+							position, tokenIndex = position464, tokenIndex464
+						}
+						add(rulePegText, position462)
+					}
+					if !_rules[ruleAction113]() {
+						goto l461
+					}
+					goto l424
+				l461:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('n') {
+						goto l467
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l467
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l467
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l467
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l467
+					}
+					position++
+					if !_rules[ruleAction114]() {
+						goto l467
+					}
+					goto l424
+				l467:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('n') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l468
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l468
+					}
+					position++
+					if !_rules[ruleAction115]() {
+						goto l468
+					}
+					goto l424
+				l468:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('h') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l469
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l469
+					}
+					position++
+					if !_rules[ruleAction116]() {
+						goto l469
+					}
+					goto l424
+				l469:
+					position, tokenIndex = position424, tokenIndex424
+					if buffer[position] != rune('m') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l422
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l422
+					}
+					position++
+					if !_rules[ruleAction117]() {
+						goto l422
+					}
+				}
+			l424:
+				add(ruleaddroption, position423)
+			}
+			return true
+		l422:
+			position, tokenIndex = position422, tokenIndex422
+			return false
+		},
+		/* 15 vethend0 <- <(<(!' ' .)+> Action118 spaces netns Action119)> */
+		func() bool {
+			position470, tokenIndex470 := position, tokenIndex
+			{
+				position471 := position
+				{
+					position472 := position
+					{
+						position475, tokenIndex475 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l475
+						}
+						position++
+						goto l470
+					l475:
+						position, tokenIndex = position475, tokenIndex475
+					}
+					if !matchDot() {
+						goto l470
+					}
+				l473:
+					{
+						position474, tokenIndex474 := position, tokenIndex
+						{
+							position476, tokenIndex476 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l476
+							}
+							position++
+							goto l474
+						l476:
+							position, tokenIndex = position476, tokenIndex476
+						}
+						if !matchDot() {
+							goto l474
+						}
+						goto l473
+					l474:
+						position, tokenIndex = position474, tokenIndex474
+					}
+					add(rulePegText, position472)
+				}
+				if !_rules[ruleAction118]() {
+					goto l470
+				}
+				if !_rules[rulespaces]() {
+					goto l470
+				}
+				if !_rules[rulenetns]() {
+					goto l470
+				}
+				if !_rules[ruleAction119]() {
+					goto l470
+				}
+				add(rulevethend0, position471)
+			}
+			return true
+		l470:
+			position, tokenIndex = position470, tokenIndex470
+			return false
+		},
+		/* 16 vethend1 <- <(<(!' ' .)+> Action120 spaces netns Action121)> */
+		func() bool {
+			position477, tokenIndex477 := position, tokenIndex
+			{
+				position478 := position
+				{
+					position479 := position
+					{
+						position482, tokenIndex482 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l482
+						}
+						position++
+						goto l477
+					l482:
+						position, tokenIndex = position482, tokenIndex482
+					}
+					if !matchDot() {
+						goto l477
+					}
+				l480:
+					{
+						position481, tokenIndex481 := position, tokenIndex
+						{
+							position483, tokenIndex483 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l483
+							}
+							position++
+							goto l481
+						l483:
+							position, tokenIndex = position483, tokenIndex483
+						}
+						if !matchDot() {
+							goto l481
+						}
+						goto l480
+					l481:
+						position, tokenIndex = position481, tokenIndex481
+					}
+					add(rulePegText, position479)
+				}
+				if !_rules[ruleAction120]() {
+					goto l477
+				}
+				if !_rules[rulespaces]() {
+					goto l477
+				}
+				if !_rules[rulenetns]() {
+					goto l477
+				}
+				if !_rules[ruleAction121]() {
+					goto l477
+				}
+				add(rulevethend1, position478)
+			}
+			return true
+		l477:
+			position, tokenIndex = position477, tokenIndex477
+			return false
+		},
+		/* 17 vethaddress <- <('a' 'd' 'd' 'r' 'e' 's' 's' spaces <(!' ' .)+> Action122 spaces <(!' ' .)+> Action123)> */
+		func() bool {
+			position484, tokenIndex484 := position, tokenIndex
+			{
+				position485 := position
+				if buffer[position] != rune('a') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l484
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l484
+				}
+				position++
+				if !_rules[rulespaces]() {
+					goto l484
+				}
+				{
+					position486 := position
+					{
+						position489, tokenIndex489 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l489
+						}
+						position++
+						goto l484
+					l489:
+						position, tokenIndex = position489, tokenIndex489
+					}
+					if !matchDot() {
+						goto l484
+					}
+				l487:
+					{
+						position488, tokenIndex488 := position, tokenIndex
+						{
+							position490, tokenIndex490 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l490
+							}
+							position++
+							goto l488
+						l490:
+							position, tokenIndex = position490, tokenIndex490
+						}
+						if !matchDot() {
+							goto l488
+						}
+						goto l487
+					l488:
+						position, tokenIndex = position488, tokenIndex488
+					}
+					add(rulePegText, position486)
+				}
+				if !_rules[ruleAction122]() {
+					goto l484
+				}
+				if !_rules[rulespaces]() {
+					goto l484
+				}
+				{
+					position491 := position
+					{
+						position494, tokenIndex494 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l494
+						}
+						position++
+						goto l484
+					l494:
+						position, tokenIndex = position494, tokenIndex494
+					}
+					if !matchDot() {
+						goto l484
+					}
+				l492:
+					{
+						position493, tokenIndex493 := position, tokenIndex
+						{
+							position495, tokenIndex495 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l495
+							}
+							position++
+							goto l493
+						l495:
+							position, tokenIndex = position495, tokenIndex495
+						}
+						if !matchDot() {
+							goto l493
+						}
+						goto l492
+					l493:
+						position, tokenIndex = position493, tokenIndex493
+					}
+					add(rulePegText, position491)
+				}
+				if !_rules[ruleAction123]() {
+					goto l484
+				}
+				add(rulevethaddress, position485)
+			}
+			return true
+		l484:
+			position, tokenIndex = position484, tokenIndex484
+			return false
+		},
+		/* 18 linkname <- <(<(!' ' .)+> Action124)> */
+		func() bool {
+			position496, tokenIndex496 := position, tokenIndex
+			{
+				position497 := position
+				{
+					position498 := position
+					{
+						position501, tokenIndex501 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l501
+						}
+						position++
+						goto l496
+					l501:
+						position, tokenIndex = position501, tokenIndex501
+					}
+					if !matchDot() {
+						goto l496
+					}
+				l499:
+					{
+						position500, tokenIndex500 := position, tokenIndex
+						{
+							position502, tokenIndex502 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l502
+							}
+							position++
+							goto l500
+						l502:
+							position, tokenIndex = position502, tokenIndex502
+						}
+						if !matchDot() {
+							goto l500
+						}
+						goto l499
+					l500:
+						position, tokenIndex = position500, tokenIndex500
+					}
+					add(rulePegText, position498)
+				}
+				if !_rules[ruleAction124]() {
+					goto l496
+				}
+				add(rulelinkname, position497)
+			}
+			return true
+		l496:
+			position, tokenIndex = position496, tokenIndex496
+			return false
+		},
+		/* 19 linktype <- <(<(('v' 'l' 'a' 'n') / ('m' 'a' 'c' 'v' 'l' 'a' 'n') / ('i' 'p' 'v' 'l' 'a' 'n') / ('v' 'x' 'l' 'a' 'n') / ('g' 'r' 'e') / ('i' 'p' 'i' 'p') / ('i' 'p' '6' 't' 'n' 'l') / ('b' 'r' 'i' 'd' 'g' 'e') / ('b' 'o' 'n' 'd') / ('v' 'r' 'f'))> Action125)> */
+		func() bool {
+			position503, tokenIndex503 := position, tokenIndex
+			{
+				position504 := position
+				{
+					position505 := position
+					{
+						position506, tokenIndex506 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l507
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l507
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l507
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l507
+						}
+						position++
+						goto l506
+					l507:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('m') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l508
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l508
+						}
+						position++
+						goto l506
+					l508:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('i') {
+							goto l509
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l509
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l509
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l509
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l509
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l509
+						}
+						position++
+						goto l506
+					l509:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('v') {
+							goto l510
+						}
+						position++
+						if buffer[position] != rune('x') {
+							goto l510
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l510
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l510
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l510
+						}
+						position++
+						goto l506
+					l510:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('g') {
+							goto l511
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l511
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l511
+						}
+						position++
+						goto l506
+					l511:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('i') {
+							goto l512
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l512
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l512
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l512
+						}
+						position++
+						goto l506
+					l512:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('i') {
+							goto l513
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l513
+						}
+						position++
+						if buffer[position] != rune('6') {
+							goto l513
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l513
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l513
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l513
+						}
+						position++
+						goto l506
+					l513:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('b') {
+							goto l514
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l514
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l514
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l514
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l514
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l514
+						}
+						position++
+						goto l506
+					l514:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('b') {
+							goto l515
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l515
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l515
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l515
+						}
+						position++
+						goto l506
+					l515:
+						position, tokenIndex = position506, tokenIndex506
+						if buffer[position] != rune('v') {
+							goto l503
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l503
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l503
+						}
+						position++
+					}
+				l506:
+					add(rulePegText, position505)
+				}
+				if !_rules[ruleAction125]() {
+					goto l503
+				}
+				add(rulelinktype, position504)
+			}
+			return true
+		l503:
+			position, tokenIndex = position503, tokenIndex503
+			return false
+		},
+		/* 20 linkaddoption <- <(('l' 'i' 'n' 'k' spaces <(!' ' .)+> Action126) / ('d' 'e' 'v' spaces <(!' ' .)+> Action127) / ('l' 'o' 'c' 'a' 'l' spaces <(!' ' .)+> Action128) / ('r' 'e' 'm' 'o' 't' 'e' spaces <(!' ' .)+> Action129) / ('d' 's' 't' 'p' 'o' 'r' 't' spaces <(!' ' .)+> Action130) / ('s' 't' 'p' spaces <(!' ' .)+> Action131) / ('v' 'l' 'a' 'n' '_' 'f' 'i' 'l' 't' 'e' 'r' 'i' 'n' 'g' spaces <(!' ' .)+> Action132) / ('m' 'i' 'i' 'm' 'o' 'n' spaces <(!' ' .)+> Action133) / ('t' 'a' 'b' 'l' 'e' spaces <(!' ' .)+> Action134) / ('i' 'd' spaces <(!' ' .)+> Action135) / ('m' 'o' 'd' 'e' spaces <(!' ' .)+> Action136) / ('n' 'a' 'm' 'e' spaces <(!' ' .)+> Action137) / ('u' 'p' Action138))> */
+		func() bool {
+			position516, tokenIndex516 := position, tokenIndex
+			{
+				position517 := position
+				{
+					position518, tokenIndex518 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l519
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l519
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l519
+					}
+					{
+						position520 := position
+						{
+							position523, tokenIndex523 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l523
+							}
+							position++
+							goto l519
+						l523:
+							position, tokenIndex = position523, tokenIndex523
+						}
+						if !matchDot() {
+							goto l519
+						}
+					l521:
+						{
+							position522, tokenIndex522 := position, tokenIndex
+							{
+								position524, tokenIndex524 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l524
+								}
+								position++
+								goto l522
+							l524:
+								position, tokenIndex = position524, tokenIndex524
+							}
+							if !matchDot() {
+								goto l522
+							}
+							goto l521
+						l522:
+							position, tokenIndex = position522, tokenIndex522
+						}
+						add(rulePegText, position520)
+					}
+					if !_rules[ruleAction126]() {
+						goto l519
+					}
+					goto l518
+				l519:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('d') {
+						goto l525
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l525
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l525
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l525
+					}
+					{
+						position526 := position
+						{
+							position529, tokenIndex529 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l529
+							}
+							position++
+							goto l525
+						l529:
+							position, tokenIndex = position529, tokenIndex529
+						}
+						if !matchDot() {
+							goto l525
+						}
+					l527:
+						{
+							position528, tokenIndex528 := position, tokenIndex
+							{
+								position530, tokenIndex530 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l530
+								}
+								position++
+								goto l528
+							l530:
+								position, tokenIndex = position530, tokenIndex530
+							}
+							if !matchDot() {
+								goto l528
+							}
+							goto l527
+						l528:
+							position, tokenIndex = position528, tokenIndex528
+						}
+						add(rulePegText, position526)
+					}
+					if !_rules[ruleAction127]() {
+						goto l525
+					}
+					goto l518
+				l525:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('l') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l531
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l531
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l531
+					}
+					{
+						position532 := position
+						{
+							position535, tokenIndex535 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l535
+							}
+							position++
+							goto l531
+						l535:
+							position, tokenIndex = position535, tokenIndex535
+						}
+						if !matchDot() {
+							goto l531
+						}
+					l533:
+						{
+							position534, tokenIndex534 := position, tokenIndex
+							{
+								position536, tokenIndex536 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l536
+								}
+								position++
+								goto l534
+							l536:
+								position, tokenIndex = position536, tokenIndex536
+							}
+							if !matchDot() {
+								goto l534
+							}
+							goto l533
+						l534:
+							position, tokenIndex = position534, tokenIndex534
+						}
+						add(rulePegText, position532)
+					}
+					if !_rules[ruleAction128]() {
+						goto l531
+					}
+					goto l518
+				l531:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('r') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l537
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l537
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l537
+					}
+					{
+						position538 := position
+						{
+							position541, tokenIndex541 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l541
+							}
+							position++
+							goto l537
+						l541:
+							position, tokenIndex = position541, tokenIndex541
+						}
+						if !matchDot() {
+							goto l537
+						}
+					l539:
+						{
+							position540, tokenIndex540 := position, tokenIndex
+							{
+								position542, tokenIndex542 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l542
+								}
+								position++
+								goto l540
+							l542:
+								position, tokenIndex = position542, tokenIndex542
+							}
+							if !matchDot() {
+								goto l540
+							}
+							goto l539
+						l540:
+							position, tokenIndex = position540, tokenIndex540
+						}
+						add(rulePegText, position538)
+					}
+					if !_rules[ruleAction129]() {
+						goto l537
+					}
+					goto l518
+				l537:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('d') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l543
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l543
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l543
+					}
+					{
+						position544 := position
+						{
+							position547, tokenIndex547 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l547
+							}
+							position++
+							goto l543
+						l547:
+							position, tokenIndex = position547, tokenIndex547
+						}
+						if !matchDot() {
+							goto l543
+						}
+					l545:
+						{
+							position546, tokenIndex546 := position, tokenIndex
+							{
+								position548, tokenIndex548 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l548
+								}
+								position++
+								goto l546
+							l548:
+								position, tokenIndex = position548, tokenIndex548
+							}
+							if !matchDot() {
+								goto l546
+							}
+							goto l545
+						l546:
+							position, tokenIndex = position546, tokenIndex546
+						}
+						add(rulePegText, position544)
+					}
+					if !_rules[ruleAction130]() {
+						goto l543
+					}
+					goto l518
+				l543:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('s') {
+						goto l549
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l549
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l549
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l549
+					}
+					{
+						position550 := position
+						{
+							position553, tokenIndex553 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l553
+							}
+							position++
+							goto l549
+						l553:
+							position, tokenIndex = position553, tokenIndex553
+						}
+						if !matchDot() {
+							goto l549
+						}
+					l551:
+						{
+							position552, tokenIndex552 := position, tokenIndex
+							{
+								position554, tokenIndex554 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l554
+								}
+								position++
+								goto l552
+							l554:
+								position, tokenIndex = position554, tokenIndex554
+							}
+							if !matchDot() {
+								goto l552
+							}
+							goto l551
+						l552:
+							position, tokenIndex = position552, tokenIndex552
+						}
+						add(rulePegText, position550)
+					}
+					if !_rules[ruleAction131]() {
+						goto l549
+					}
+					goto l518
+				l549:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('v') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l555
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l555
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l555
+					}
+					{
+						position556 := position
+						{
+							position559, tokenIndex559 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l559
+							}
+							position++
+							goto l555
+						l559:
+							position, tokenIndex = position559, tokenIndex559
+						}
+						if !matchDot() {
+							goto l555
+						}
+					l557:
+						{
+							position558, tokenIndex558 := position, tokenIndex
+							{
+								position560, tokenIndex560 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l560
+								}
+								position++
+								goto l558
+							l560:
+								position, tokenIndex = position560, tokenIndex560
+							}
+							if !matchDot() {
+								goto l558
+							}
+							goto l557
+						l558:
+							position, tokenIndex = position558, tokenIndex558
+						}
+						add(rulePegText, position556)
+					}
+					if !_rules[ruleAction132]() {
+						goto l555
+					}
+					goto l518
+				l555:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('m') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l561
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l561
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l561
+					}
+					{
+						position562 := position
+						{
+							position565, tokenIndex565 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l565
+							}
+							position++
+							goto l561
+						l565:
+							position, tokenIndex = position565, tokenIndex565
+						}
+						if !matchDot() {
+							goto l561
+						}
+					l563:
+						{
+							position564, tokenIndex564 := position, tokenIndex
+							{
+								position566, tokenIndex566 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l566
+								}
+								position++
+								goto l564
+							l566:
+								position, tokenIndex = position566, tokenIndex566
+							}
+							if !matchDot() {
+								goto l564
+							}
+							goto l563
+						l564:
+							position, tokenIndex = position564, tokenIndex564
+						}
+						add(rulePegText, position562)
+					}
+					if !_rules[ruleAction133]() {
+						goto l561
+					}
+					goto l518
+				l561:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('t') {
+						goto l567
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l567
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l567
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l567
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l567
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l567
+					}
+					{
+						position568 := position
+						{
+							position571, tokenIndex571 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l571
+							}
+							position++
+							goto l567
+						l571:
+							position, tokenIndex = position571, tokenIndex571
+						}
+						if !matchDot() {
+							goto l567
+						}
+					l569:
+						{
+							position570, tokenIndex570 := position, tokenIndex
+							{
+								position572, tokenIndex572 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l572
+								}
+								position++
+								goto l570
+							l572:
+								position, tokenIndex = position572, tokenIndex572
+							}
+							if !matchDot() {
+								goto l570
+							}
+							goto l569
+						l570:
+							position, tokenIndex = position570, tokenIndex570
+						}
+						add(rulePegText, position568)
+					}
+					if !_rules[ruleAction134]() {
+						goto l567
+					}
+					goto l518
+				l567:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('i') {
+						goto l573
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l573
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l573
+					}
+					{
+						position574 := position
+						{
+							position577, tokenIndex577 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l577
+							}
+							position++
+							goto l573
+						l577:
+							position, tokenIndex = position577, tokenIndex577
+						}
+						if !matchDot() {
+							goto l573
+						}
+					l575:
+						{
+							position576, tokenIndex576 := position, tokenIndex
+							{
+								position578, tokenIndex578 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l578
+								}
+								position++
+								goto l576
+							l578:
+								position, tokenIndex = position578, tokenIndex578
+							}
+							if !matchDot() {
+								goto l576
+							}
+							goto l575
+						l576:
+							position, tokenIndex = position576, tokenIndex576
+						}
+						add(rulePegText, position574)
+					}
+					if !_rules[ruleAction135]() {
+						goto l573
+					}
+					goto l518
+				l573:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('m') {
+						goto l579
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l579
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l579
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l579
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l579
+					}
+					{
+						position580 := position
+						{
+							position583, tokenIndex583 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l583
+							}
+							position++
+							goto l579
+						l583:
+							position, tokenIndex = position583, tokenIndex583
+						}
+						if !matchDot() {
+							goto l579
+						}
+					l581:
+						{
+							position582, tokenIndex582 := position, tokenIndex
+							{
+								position584, tokenIndex584 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l584
+								}
+								position++
+								goto l582
+							l584:
+								position, tokenIndex = position584, tokenIndex584
+							}
+							if !matchDot() {
+								goto l582
+							}
+							goto l581
+						l582:
+							position, tokenIndex = position582, tokenIndex582
+						}
+						add(rulePegText, position580)
+					}
+					if !_rules[ruleAction136]() {
+						goto l579
+					}
+					goto l518
+				l579:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('n') {
+						goto l585
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l585
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l585
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l585
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l585
+					}
+					{
+						position586 := position
+						{
+							position589, tokenIndex589 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l589
+							}
+							position++
+							goto l585
+						l589:
+							position, tokenIndex = position589, tokenIndex589
+						}
+						if !matchDot() {
+							goto l585
+						}
+					l587:
+						{
+							position588, tokenIndex588 := position, tokenIndex
+							{
+								position590, tokenIndex590 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l590
+								}
+								position++
+								goto l588
+							l590:
+								position, tokenIndex = position590, tokenIndex590
+							}
+							if !matchDot() {
+								goto l588
+							}
+							goto l587
+						l588:
+							position, tokenIndex = position588, tokenIndex588
+						}
+						add(rulePegText, position586)
+					}
+					if !_rules[ruleAction137]() {
+						goto l585
+					}
+					goto l518
+				l585:
+					position, tokenIndex = position518, tokenIndex518
+					if buffer[position] != rune('u') {
+						goto l516
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l516
+					}
+					position++
+					if !_rules[ruleAction138]() {
+						goto l516
+					}
+				}
+			l518:
+				add(rulelinkaddoption, position517)
+			}
+			return true
+		l516:
+			position, tokenIndex = position516, tokenIndex516
+			return false
+		},
+		/* 21 linkoption <- <(('u' 'p' Action139) / ('d' 'o' 'w' 'n' Action140) / ('m' 't' 'u' spaces <(!' ' .)+> Action141) / ('a' 'd' 'd' 'r' 'e' 's' 's' spaces <(!' ' .)+> Action142) / ('n' 'a' 'm' 'e' spaces <(!' ' .)+> Action143) / ('t' 'x' 'q' 'u' 'e' 'u' 'e' 'l' 'e' 'n' spaces <(!' ' .)+> Action144) / ('a' 'l' 'i' 'a' 's' spaces <(!' ' .)+> Action145) / ('m' 'a' 's' 't' 'e' 'r' spaces <(!' ' .)+> Action146) / ('n' 'o' 'm' 'a' 's' 't' 'e' 'r' Action147))> */
+		func() bool {
+			position591, tokenIndex591 := position, tokenIndex
+			{
+				position592 := position
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l594
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l594
+					}
+					position++
+					if !_rules[ruleAction139]() {
+						goto l594
+					}
+					goto l593
+				l594:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('d') {
+						goto l595
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l595
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l595
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l595
+					}
+					position++
+					if !_rules[ruleAction140]() {
+						goto l595
+					}
+					goto l593
+				l595:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('m') {
+						goto l596
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l596
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l596
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l596
+					}
+					{
+						position597 := position
+						{
+							position600, tokenIndex600 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l600
+							}
+							position++
+							goto l596
+						l600:
+							position, tokenIndex = position600, tokenIndex600
+						}
+						if !matchDot() {
+							goto l596
+						}
+					l598:
+						{
+							position599, tokenIndex599 := position, tokenIndex
+							{
+								position601, tokenIndex601 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l601
+								}
+								position++
+								goto l599
+							l601:
+								position, tokenIndex = position601, tokenIndex601
+							}
+							if !matchDot() {
+								goto l599
+							}
+							goto l598
+						l599:
+							position, tokenIndex = position599, tokenIndex599
+						}
+						add(rulePegText, position597)
+					}
+					if !_rules[ruleAction141]() {
+						goto l596
+					}
+					goto l593
+				l596:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('a') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l602
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l602
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l602
+					}
+					{
+						position603 := position
+						{
+							position606, tokenIndex606 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l606
+							}
+							position++
+							goto l602
+						l606:
+							position, tokenIndex = position606, tokenIndex606
+						}
+						if !matchDot() {
+							goto l602
+						}
+					l604:
+						{
+							position605, tokenIndex605 := position, tokenIndex
+							{
+								position607, tokenIndex607 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l607
+								}
+								position++
+								goto l605
+							l607:
+								position, tokenIndex = position607, tokenIndex607
+							}
+							if !matchDot() {
+								goto l605
+							}
+							goto l604
+						l605:
+							position, tokenIndex = position605, tokenIndex605
+						}
+						add(rulePegText, position603)
+					}
+					if !_rules[ruleAction142]() {
+						goto l602
+					}
+					goto l593
+				l602:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('n') {
+						goto l608
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l608
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l608
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l608
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l608
+					}
+					{
+						position609 := position
+						{
+							position612, tokenIndex612 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l612
+							}
+							position++
+							goto l608
+						l612:
+							position, tokenIndex = position612, tokenIndex612
+						}
+						if !matchDot() {
+							goto l608
+						}
+					l610:
+						{
+							position611, tokenIndex611 := position, tokenIndex
+							{
+								position613, tokenIndex613 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l613
+								}
+								position++
+								goto l611
+							l613:
+								position, tokenIndex = position613, tokenIndex613
+							}
+							if !matchDot() {
+								goto l611
+							}
+							goto l610
+						l611:
+							position, tokenIndex = position611, tokenIndex611
+						}
+						add(rulePegText, position609)
+					}
+					if !_rules[ruleAction143]() {
+						goto l608
+					}
+					goto l593
+				l608:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('t') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('q') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l614
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l614
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l614
+					}
+					{
+						position615 := position
+						{
+							position618, tokenIndex618 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l618
+							}
+							position++
+							goto l614
+						l618:
+							position, tokenIndex = position618, tokenIndex618
+						}
+						if !matchDot() {
+							goto l614
+						}
+					l616:
+						{
+							position617, tokenIndex617 := position, tokenIndex
+							{
+								position619, tokenIndex619 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l619
+								}
+								position++
+								goto l617
+							l619:
+								position, tokenIndex = position619, tokenIndex619
+							}
+							if !matchDot() {
+								goto l617
+							}
+							goto l616
+						l617:
+							position, tokenIndex = position617, tokenIndex617
+						}
+						add(rulePegText, position615)
+					}
+					if !_rules[ruleAction144]() {
+						goto l614
+					}
+					goto l593
+				l614:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('a') {
+						goto l620
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l620
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l620
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l620
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l620
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l620
+					}
+					{
+						position621 := position
+						{
+							position624, tokenIndex624 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l624
+							}
+							position++
+							goto l620
+						l624:
+							position, tokenIndex = position624, tokenIndex624
+						}
+						if !matchDot() {
+							goto l620
+						}
+					l622:
+						{
+							position623, tokenIndex623 := position, tokenIndex
+							{
+								position625, tokenIndex625 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l625
+								}
+								position++
+								goto l623
+							l625:
+								position, tokenIndex = position625, tokenIndex625
+							}
+							if !matchDot() {
+								goto l623
+							}
+							goto l622
+						l623:
+							position, tokenIndex = position623, tokenIndex623
+						}
+						add(rulePegText, position621)
+					}
+					if !_rules[ruleAction145]() {
+						goto l620
+					}
+					goto l593
+				l620:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('m') {
+						goto l626
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l626
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l626
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l626
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l626
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l626
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l626
+					}
+					{
+						position627 := position
+						{
+							position630, tokenIndex630 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l630
+							}
+							position++
+							goto l626
+						l630:
+							position, tokenIndex = position630, tokenIndex630
+						}
+						if !matchDot() {
+							goto l626
+						}
+					l628:
+						{
+							position629, tokenIndex629 := position, tokenIndex
+							{
+								position631, tokenIndex631 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l631
+								}
+								position++
+								goto l629
+							l631:
+								position, tokenIndex = position631, tokenIndex631
+							}
+							if !matchDot() {
+								goto l629
+							}
+							goto l628
+						l629:
+							position, tokenIndex = position629, tokenIndex629
+						}
+						add(rulePegText, position627)
+					}
+					if !_rules[ruleAction146]() {
+						goto l626
+					}
+					goto l593
+				l626:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('n') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l591
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l591
+					}
+					position++
+					if !_rules[ruleAction147]() {
+						goto l591
+					}
+				}
+			l593:
+				add(rulelinkoption, position592)
+			}
+			return true
+		l591:
+			position, tokenIndex = position591, tokenIndex591
+			return false
+		},
+		/* 22 qdisckind <- <(<(('n' 'e' 't' 'e' 'm') / ('t' 'b' 'f') / ('h' 't' 'b'))> Action148)> */
+		func() bool {
+			position632, tokenIndex632 := position, tokenIndex
+			{
+				position633 := position
+				{
+					position634 := position
+					{
+						position635, tokenIndex635 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l636
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l636
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l636
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l636
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l636
+						}
+						position++
+						goto l635
+					l636:
+						position, tokenIndex = position635, tokenIndex635
+						if buffer[position] != rune('t') {
+							goto l637
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l637
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l637
+						}
+						position++
+						goto l635
+					l637:
+						position, tokenIndex = position635, tokenIndex635
+						if buffer[position] != rune('h') {
+							goto l632
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l632
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l632
+						}
+						position++
+					}
+				l635:
+					add(rulePegText, position634)
+				}
+				if !_rules[ruleAction148]() {
+					goto l632
+				}
+				add(ruleqdisckind, position633)
+			}
+			return true
+		l632:
+			position, tokenIndex = position632, tokenIndex632
+			return false
+		},
+		/* 23 qdiscoption <- <(('d' 'e' 'v' spaces <(!' ' .)+> Action149) / ('p' 'a' 'r' 'e' 'n' 't' spaces <(!' ' .)+> Action150) / ('h' 'a' 'n' 'd' 'l' 'e' spaces <(!' ' .)+> Action151) / ('c' 'l' 'a' 's' 's' 'i' 'd' spaces <(!' ' .)+> Action152) / ('d' 'e' 'l' 'a' 'y' spaces <(!' ' .)+> Action153) / ('j' 'i' 't' 't' 'e' 'r' spaces <(!' ' .)+> Action154) / ('l' 'o' 's' 's' spaces <(!' ' .)+> Action155) / ('d' 'u' 'p' 'l' 'i' 'c' 'a' 't' 'e' spaces <(!' ' .)+> Action156) / ('r' 'a' 't' 'e' spaces <(!' ' .)+> Action157) / ('c' 'e' 'i' 'l' spaces <(!' ' .)+> Action158) / ('b' 'u' 'r' 's' 't' spaces <(!' ' .)+> Action159) / ('l' 'a' 't' 'e' 'n' 'c' 'y' spaces <(!' ' .)+> Action160) / ('d' 'e' 'f' 'a' 'u' 'l' 't' spaces <(!' ' .)+> Action161) / ('r' 'o' 'o' 't' Action162) / qdisckind)> */
+		func() bool {
+			position638, tokenIndex638 := position, tokenIndex
+			{
+				position639 := position
+				{
+					position640, tokenIndex640 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l641
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l641
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l641
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l641
+					}
+					{
+						position642 := position
+						{
+							position645, tokenIndex645 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l645
+							}
+							position++
+							goto l641
+						l645:
+							position, tokenIndex = position645, tokenIndex645
+						}
+						if !matchDot() {
+							goto l641
+						}
+					l643:
+						{
+							position644, tokenIndex644 := position, tokenIndex
+							{
+								position646, tokenIndex646 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l646
+								}
+								position++
+								goto l644
+							l646:
+								position, tokenIndex = position646, tokenIndex646
+							}
+							if !matchDot() {
+								goto l644
+							}
+							goto l643
+						l644:
+							position, tokenIndex = position644, tokenIndex644
+						}
+						add(rulePegText, position642)
+					}
+					if !_rules[ruleAction149]() {
+						goto l641
+					}
+					goto l640
+				l641:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('p') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l647
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l647
+					}
+					{
+						position648 := position
+						{
+							position651, tokenIndex651 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l651
+							}
+							position++
+							goto l647
+						l651:
+							position, tokenIndex = position651, tokenIndex651
+						}
+						if !matchDot() {
+							goto l647
+						}
+					l649:
+						{
+							position650, tokenIndex650 := position, tokenIndex
+							{
+								position652, tokenIndex652 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l652
+								}
+								position++
+								goto l650
+							l652:
+								position, tokenIndex = position652, tokenIndex652
+							}
+							if !matchDot() {
+								goto l650
+							}
+							goto l649
+						l650:
+							position, tokenIndex = position650, tokenIndex650
+						}
+						add(rulePegText, position648)
+					}
+					if !_rules[ruleAction150]() {
+						goto l647
+					}
+					goto l640
+				l647:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('h') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l653
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l653
+					}
+					{
+						position654 := position
+						{
+							position657, tokenIndex657 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l657
+							}
+							position++
+							goto l653
+						l657:
+							position, tokenIndex = position657, tokenIndex657
+						}
+						if !matchDot() {
+							goto l653
+						}
+					l655:
+						{
+							position656, tokenIndex656 := position, tokenIndex
+							{
+								position658, tokenIndex658 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l658
+								}
+								position++
+								goto l656
+							l658:
+								position, tokenIndex = position658, tokenIndex658
+							}
+							if !matchDot() {
+								goto l656
+							}
+							goto l655
+						l656:
+							position, tokenIndex = position656, tokenIndex656
+						}
+						add(rulePegText, position654)
+					}
+					if !_rules[ruleAction151]() {
+						goto l653
+					}
+					goto l640
+				l653:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('c') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l659
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l659
+					}
+					{
+						position660 := position
+						{
+							position663, tokenIndex663 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l663
+							}
+							position++
+							goto l659
+						l663:
+							position, tokenIndex = position663, tokenIndex663
+						}
+						if !matchDot() {
+							goto l659
+						}
+					l661:
+						{
+							position662, tokenIndex662 := position, tokenIndex
+							{
+								position664, tokenIndex664 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l664
+								}
+								position++
+								goto l662
+							l664:
+								position, tokenIndex = position664, tokenIndex664
+							}
+							if !matchDot() {
+								goto l662
+							}
+							goto l661
+						l662:
+							position, tokenIndex = position662, tokenIndex662
+						}
+						add(rulePegText, position660)
+					}
+					if !_rules[ruleAction152]() {
+						goto l659
+					}
+					goto l640
+				l659:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('d') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l665
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l665
+					}
+					{
+						position666 := position
+						{
+							position669, tokenIndex669 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l669
+							}
+							position++
+							goto l665
+						l669:
+							position, tokenIndex = position669, tokenIndex669
+						}
+						if !matchDot() {
+							goto l665
+						}
+					l667:
+						{
+							position668, tokenIndex668 := position, tokenIndex
+							{
+								position670, tokenIndex670 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l670
+								}
+								position++
+								goto l668
+							l670:
+								position, tokenIndex = position670, tokenIndex670
+							}
+							if !matchDot() {
+								goto l668
+							}
+							goto l667
+						l668:
+							position, tokenIndex = position668, tokenIndex668
+						}
+						add(rulePegText, position666)
+					}
+					if !_rules[ruleAction153]() {
+						goto l665
+					}
+					goto l640
+				l665:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('j') {
+						goto l671
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l671
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l671
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l671
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l671
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l671
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l671
+					}
+					{
+						position672 := position
+						{
+							position675, tokenIndex675 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l675
+							}
+							position++
+							goto l671
+						l675:
+							position, tokenIndex = position675, tokenIndex675
+						}
+						if !matchDot() {
+							goto l671
+						}
+					l673:
+						{
+							position674, tokenIndex674 := position, tokenIndex
+							{
+								position676, tokenIndex676 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l676
+								}
+								position++
+								goto l674
+							l676:
+								position, tokenIndex = position676, tokenIndex676
+							}
+							if !matchDot() {
+								goto l674
+							}
+							goto l673
+						l674:
+							position, tokenIndex = position674, tokenIndex674
+						}
+						add(rulePegText, position672)
+					}
+					if !_rules[ruleAction154]() {
+						goto l671
+					}
+					goto l640
+				l671:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('l') {
+						goto l677
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l677
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l677
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l677
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l677
+					}
+					{
+						position678 := position
+						{
+							position681, tokenIndex681 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l681
+							}
+							position++
+							goto l677
+						l681:
+							position, tokenIndex = position681, tokenIndex681
+						}
+						if !matchDot() {
+							goto l677
+						}
+					l679:
+						{
+							position680, tokenIndex680 := position, tokenIndex
+							{
+								position682, tokenIndex682 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l682
+								}
+								position++
+								goto l680
+							l682:
+								position, tokenIndex = position682, tokenIndex682
+							}
+							if !matchDot() {
+								goto l680
+							}
+							goto l679
+						l680:
+							position, tokenIndex = position680, tokenIndex680
+						}
+						add(rulePegText, position678)
+					}
+					if !_rules[ruleAction155]() {
+						goto l677
+					}
+					goto l640
+				l677:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('d') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l683
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l683
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l683
+					}
+					{
+						position684 := position
+						{
+							position687, tokenIndex687 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l687
+							}
+							position++
+							goto l683
+						l687:
+							position, tokenIndex = position687, tokenIndex687
+						}
+						if !matchDot() {
+							goto l683
+						}
+					l685:
+						{
+							position686, tokenIndex686 := position, tokenIndex
+							{
+								position688, tokenIndex688 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l688
+								}
+								position++
+								goto l686
+							l688:
+								position, tokenIndex = position688, tokenIndex688
+							}
+							if !matchDot() {
+								goto l686
+							}
+							goto l685
+						l686:
+							position, tokenIndex = position686, tokenIndex686
+						}
+						add(rulePegText, position684)
+					}
+					if !_rules[ruleAction156]() {
+						goto l683
+					}
+					goto l640
+				l683:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('r') {
+						goto l689
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l689
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l689
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l689
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l689
+					}
+					{
+						position690 := position
+						{
+							position693, tokenIndex693 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l693
+							}
+							position++
+							goto l689
+						l693:
+							position, tokenIndex = position693, tokenIndex693
+						}
+						if !matchDot() {
+							goto l689
+						}
+					l691:
+						{
+							position692, tokenIndex692 := position, tokenIndex
+							{
+								position694, tokenIndex694 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l694
+								}
+								position++
+								goto l692
+							l694:
+								position, tokenIndex = position694, tokenIndex694
+							}
+							if !matchDot() {
+								goto l692
+							}
+							goto l691
+						l692:
+							position, tokenIndex = position692, tokenIndex692
+						}
+						add(rulePegText, position690)
+					}
+					if !_rules[ruleAction157]() {
+						goto l689
+					}
+					goto l640
+				l689:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('c') {
+						goto l695
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l695
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l695
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l695
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l695
+					}
+					{
+						position696 := position
+						{
+							position699, tokenIndex699 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l699
+							}
+							position++
+							goto l695
+						l699:
+							position, tokenIndex = position699, tokenIndex699
+						}
+						if !matchDot() {
+							goto l695
+						}
+					l697:
+						{
+							position698, tokenIndex698 := position, tokenIndex
+							{
+								position700, tokenIndex700 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l700
+								}
+								position++
+								goto l698
+							l700:
+								position, tokenIndex = position700, tokenIndex700
+							}
+							if !matchDot() {
+								goto l698
+							}
+							goto l697
+						l698:
+							position, tokenIndex = position698, tokenIndex698
+						}
+						add(rulePegText, position696)
+					}
+					if !_rules[ruleAction158]() {
+						goto l695
+					}
+					goto l640
+				l695:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('b') {
+						goto l701
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l701
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l701
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l701
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l701
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l701
+					}
+					{
+						position702 := position
+						{
+							position705, tokenIndex705 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l705
+							}
+							position++
+							goto l701
+						l705:
+							position, tokenIndex = position705, tokenIndex705
+						}
+						if !matchDot() {
+							goto l701
+						}
+					l703:
+						{
+							position704, tokenIndex704 := position, tokenIndex
+							{
+								position706, tokenIndex706 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l706
+								}
+								position++
+								goto l704
+							l706:
+								position, tokenIndex = position706, tokenIndex706
+							}
+							if !matchDot() {
+								goto l704
+							}
+							goto l703
+						l704:
+							position, tokenIndex = position704, tokenIndex704
+						}
+						add(rulePegText, position702)
+					}
+					if !_rules[ruleAction159]() {
+						goto l701
+					}
+					goto l640
+				l701:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('l') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l707
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l707
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l707
+					}
+					{
+						position708 := position
+						{
+							position711, tokenIndex711 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l711
+							}
+							position++
+							goto l707
+						l711:
+							position, tokenIndex = position711, tokenIndex711
+						}
+						if !matchDot() {
+							goto l707
+						}
+					l709:
+						{
+							position710, tokenIndex710 := position, tokenIndex
+							{
+								position712, tokenIndex712 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l712
+								}
+								position++
+								goto l710
+							l712:
+								position, tokenIndex = position712, tokenIndex712
+							}
+							if !matchDot() {
+								goto l710
+							}
+							goto l709
+						l710:
+							position, tokenIndex = position710, tokenIndex710
+						}
+						add(rulePegText, position708)
+					}
+					if !_rules[ruleAction160]() {
+						goto l707
+					}
+					goto l640
+				l707:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('d') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l713
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l713
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l713
+					}
+					{
+						position714 := position
+						{
+							position717, tokenIndex717 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l717
+							}
+							position++
+							goto l713
+						l717:
+							position, tokenIndex = position717, tokenIndex717
+						}
+						if !matchDot() {
+							goto l713
+						}
+					l715:
+						{
+							position716, tokenIndex716 := position, tokenIndex
+							{
+								position718, tokenIndex718 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l718
+								}
+								position++
+								goto l716
+							l718:
+								position, tokenIndex = position718, tokenIndex718
+							}
+							if !matchDot() {
+								goto l716
+							}
+							goto l715
+						l716:
+							position, tokenIndex = position716, tokenIndex716
+						}
+						add(rulePegText, position714)
+					}
+					if !_rules[ruleAction161]() {
+						goto l713
+					}
+					goto l640
+				l713:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('r') {
+						goto l719
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l719
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l719
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l719
+					}
+					position++
+					if !_rules[ruleAction162]() {
+						goto l719
+					}
+					goto l640
+				l719:
+					position, tokenIndex = position640, tokenIndex640
+					if !_rules[ruleqdisckind]() {
+						goto l638
+					}
+				}
+			l640:
+				add(ruleqdiscoption, position639)
+			}
+			return true
+		l638:
+			position, tokenIndex = position638, tokenIndex638
+			return false
+		},
+		/* 24 filterchain <- <(<(('i' 'n' 'p' 'u' 't') / ('f' 'o' 'r' 'w' 'a' 'r' 'd') / ('o' 'u' 't' 'p' 'u' 't'))> Action163)> */
+		func() bool {
+			position720, tokenIndex720 := position, tokenIndex
+			{
+				position721 := position
+				{
+					position722 := position
+					{
+						position723, tokenIndex723 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l724
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l724
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l724
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l724
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l724
+						}
+						position++
+						goto l723
+					l724:
+						position, tokenIndex = position723, tokenIndex723
+						if buffer[position] != rune('f') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('w') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l725
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l725
+						}
+						position++
+						goto l723
+					l725:
+						position, tokenIndex = position723, tokenIndex723
+						if buffer[position] != rune('o') {
+							goto l720
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l720
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l720
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l720
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l720
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l720
+						}
+						position++
+					}
+				l723:
+					add(rulePegText, position722)
+				}
+				if !_rules[ruleAction163]() {
+					goto l720
+				}
+				add(rulefilterchain, position721)
+			}
+			return true
+		l720:
+			position, tokenIndex = position720, tokenIndex720
+			return false
+		},
+		/* 25 filterverdict <- <(<(('a' 'c' 'c' 'e' 'p' 't') / ('d' 'r' 'o' 'p'))> Action164)> */
+		func() bool {
+			position726, tokenIndex726 := position, tokenIndex
+			{
+				position727 := position
+				{
+					position728 := position
+					{
+						position729, tokenIndex729 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l730
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l730
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l730
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l730
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l730
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l730
+						}
+						position++
+						goto l729
+					l730:
+						position, tokenIndex = position729, tokenIndex729
+						if buffer[position] != rune('d') {
+							goto l726
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l726
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l726
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l726
+						}
+						position++
+					}
+				l729:
+					add(rulePegText, position728)
+				}
+				if !_rules[ruleAction164]() {
+					goto l726
+				}
+				add(rulefilterverdict, position727)
+			}
+			return true
+		l726:
+			position, tokenIndex = position726, tokenIndex726
+			return false
+		},
+		/* 26 nftoption <- <(('s' 'r' 'c' spaces <(!' ' .)+> Action165) / ('d' 's' 't' spaces <(!' ' .)+> Action166) / ('i' 'i' 'f' spaces <(!' ' .)+> Action167) / ('o' 'i' 'f' spaces <(!' ' .)+> Action168) / ('p' 'r' 'o' 't' 'o' spaces <(!' ' .)+> Action169) / ('d' 'p' 'o' 'r' 't' spaces <(!' ' .)+> Action170) / ('t' 'o' spaces <(!' ' .)+> Action171))> */
+		func() bool {
+			position731, tokenIndex731 := position, tokenIndex
+			{
+				position732 := position
+				{
+					position733, tokenIndex733 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l734
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l734
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l734
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l734
+					}
+					{
+						position735 := position
+						{
+							position738, tokenIndex738 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l738
+							}
+							position++
+							goto l734
+						l738:
+							position, tokenIndex = position738, tokenIndex738
+						}
+						if !matchDot() {
+							goto l734
+						}
+					l736:
+						{
+							position737, tokenIndex737 := position, tokenIndex
+							{
+								position739, tokenIndex739 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l739
+								}
+								position++
+								goto l737
+							l739:
+								position, tokenIndex = position739, tokenIndex739
+							}
+							if !matchDot() {
+								goto l737
+							}
+							goto l736
+						l737:
+							position, tokenIndex = position737, tokenIndex737
+						}
+						add(rulePegText, position735)
+					}
+					if !_rules[ruleAction165]() {
+						goto l734
+					}
+					goto l733
+				l734:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('d') {
+						goto l740
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l740
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l740
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l740
+					}
+					{
+						position741 := position
+						{
+							position744, tokenIndex744 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l744
+							}
+							position++
+							goto l740
+						l744:
+							position, tokenIndex = position744, tokenIndex744
+						}
+						if !matchDot() {
+							goto l740
+						}
+					l742:
+						{
+							position743, tokenIndex743 := position, tokenIndex
+							{
+								position745, tokenIndex745 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l745
+								}
+								position++
+								goto l743
+							l745:
+								position, tokenIndex = position745, tokenIndex745
+							}
+							if !matchDot() {
+								goto l743
+							}
+							goto l742
+						l743:
+							position, tokenIndex = position743, tokenIndex743
+						}
+						add(rulePegText, position741)
+					}
+					if !_rules[ruleAction166]() {
+						goto l740
+					}
+					goto l733
+				l740:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('i') {
+						goto l746
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l746
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l746
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l746
+					}
+					{
+						position747 := position
+						{
+							position750, tokenIndex750 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l750
+							}
+							position++
+							goto l746
+						l750:
+							position, tokenIndex = position750, tokenIndex750
+						}
+						if !matchDot() {
+							goto l746
+						}
+					l748:
+						{
+							position749, tokenIndex749 := position, tokenIndex
+							{
+								position751, tokenIndex751 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l751
+								}
+								position++
+								goto l749
+							l751:
+								position, tokenIndex = position751, tokenIndex751
+							}
+							if !matchDot() {
+								goto l749
+							}
+							goto l748
+						l749:
+							position, tokenIndex = position749, tokenIndex749
+						}
+						add(rulePegText, position747)
+					}
+					if !_rules[ruleAction167]() {
+						goto l746
+					}
+					goto l733
+				l746:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('o') {
+						goto l752
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l752
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l752
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l752
+					}
+					{
+						position753 := position
+						{
+							position756, tokenIndex756 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l756
+							}
+							position++
+							goto l752
+						l756:
+							position, tokenIndex = position756, tokenIndex756
+						}
+						if !matchDot() {
+							goto l752
+						}
+					l754:
+						{
+							position755, tokenIndex755 := position, tokenIndex
+							{
+								position757, tokenIndex757 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l757
+								}
+								position++
+								goto l755
+							l757:
+								position, tokenIndex = position757, tokenIndex757
+							}
+							if !matchDot() {
+								goto l755
+							}
+							goto l754
+						l755:
+							position, tokenIndex = position755, tokenIndex755
+						}
+						add(rulePegText, position753)
+					}
+					if !_rules[ruleAction168]() {
+						goto l752
+					}
+					goto l733
+				l752:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('p') {
+						goto l758
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l758
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l758
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l758
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l758
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l758
+					}
+					{
+						position759 := position
+						{
+							position762, tokenIndex762 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l762
+							}
+							position++
+							goto l758
+						l762:
+							position, tokenIndex = position762, tokenIndex762
+						}
+						if !matchDot() {
+							goto l758
+						}
+					l760:
+						{
+							position761, tokenIndex761 := position, tokenIndex
+							{
+								position763, tokenIndex763 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l763
+								}
+								position++
+								goto l761
+							l763:
+								position, tokenIndex = position763, tokenIndex763
+							}
+							if !matchDot() {
+								goto l761
+							}
+							goto l760
+						l761:
+							position, tokenIndex = position761, tokenIndex761
+						}
+						add(rulePegText, position759)
+					}
+					if !_rules[ruleAction169]() {
+						goto l758
+					}
+					goto l733
+				l758:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('d') {
+						goto l764
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l764
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l764
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l764
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l764
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l764
+					}
+					{
+						position765 := position
+						{
+							position768, tokenIndex768 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l768
+							}
+							position++
+							goto l764
+						l768:
+							position, tokenIndex = position768, tokenIndex768
+						}
+						if !matchDot() {
+							goto l764
+						}
+					l766:
+						{
+							position767, tokenIndex767 := position, tokenIndex
+							{
+								position769, tokenIndex769 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l769
+								}
+								position++
+								goto l767
+							l769:
+								position, tokenIndex = position769, tokenIndex769
+							}
+							if !matchDot() {
+								goto l767
+							}
+							goto l766
+						l767:
+							position, tokenIndex = position767, tokenIndex767
+						}
+						add(rulePegText, position765)
+					}
+					if !_rules[ruleAction170]() {
+						goto l764
+					}
+					goto l733
+				l764:
+					position, tokenIndex = position733, tokenIndex733
+					if buffer[position] != rune('t') {
+						goto l731
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l731
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l731
+					}
+					{
+						position770 := position
+						{
+							position773, tokenIndex773 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l773
+							}
+							position++
+							goto l731
+						l773:
+							position, tokenIndex = position773, tokenIndex773
+						}
+						if !matchDot() {
+							goto l731
+						}
+					l771:
+						{
+							position772, tokenIndex772 := position, tokenIndex
+							{
+								position774, tokenIndex774 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l774
+								}
+								position++
+								goto l772
+							l774:
+								position, tokenIndex = position774, tokenIndex774
+							}
+							if !matchDot() {
+								goto l772
+							}
+							goto l771
+						l772:
+							position, tokenIndex = position772, tokenIndex772
+						}
+						add(rulePegText, position770)
+					}
+					if !_rules[ruleAction171]() {
+						goto l731
+					}
+				}
+			l733:
+				add(rulenftoption, position732)
+			}
+			return true
+		l731:
+			position, tokenIndex = position731, tokenIndex731
+			return false
+		},
+		/* 27 conntrackoption <- <(('s' 'r' 'c' spaces <(!' ' .)+> Action172) / ('d' 's' 't' spaces <(!' ' .)+> Action173) / ('p' 'r' 'o' 't' 'o' spaces <(!' ' .)+> Action174))> */
+		func() bool {
+			position775, tokenIndex775 := position, tokenIndex
+			{
+				position776 := position
+				{
+					position777, tokenIndex777 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l778
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l778
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l778
+					}
+					{
+						position779 := position
+						{
+							position782, tokenIndex782 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l782
+							}
+							position++
+							goto l778
+						l782:
+							position, tokenIndex = position782, tokenIndex782
+						}
+						if !matchDot() {
+							goto l778
+						}
+					l780:
+						{
+							position781, tokenIndex781 := position, tokenIndex
+							{
+								position783, tokenIndex783 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l783
+								}
+								position++
+								goto l781
+							l783:
+								position, tokenIndex = position783, tokenIndex783
+							}
+							if !matchDot() {
+								goto l781
+							}
+							goto l780
+						l781:
+							position, tokenIndex = position781, tokenIndex781
+						}
+						add(rulePegText, position779)
+					}
+					if !_rules[ruleAction172]() {
+						goto l778
+					}
+					goto l777
+				l778:
+					position, tokenIndex = position777, tokenIndex777
+					if buffer[position] != rune('d') {
+						goto l784
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l784
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l784
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l784
+					}
+					{
+						position785 := position
+						{
+							position788, tokenIndex788 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l788
+							}
+							position++
+							goto l784
+						l788:
+							position, tokenIndex = position788, tokenIndex788
+						}
+						if !matchDot() {
+							goto l784
+						}
+					l786:
+						{
+							position787, tokenIndex787 := position, tokenIndex
+							{
+								position789, tokenIndex789 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l789
+								}
+								position++
+								goto l787
+							l789:
+								position, tokenIndex = position789, tokenIndex789
+							}
+							if !matchDot() {
+								goto l787
+							}
+							goto l786
+						l787:
+							position, tokenIndex = position787, tokenIndex787
+						}
+						add(rulePegText, position785)
+					}
+					if !_rules[ruleAction173]() {
+						goto l784
+					}
+					goto l777
+				l784:
+					position, tokenIndex = position777, tokenIndex777
+					if buffer[position] != rune('p') {
+						goto l775
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l775
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l775
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l775
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l775
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l775
+					}
+					{
+						position790 := position
+						{
+							position793, tokenIndex793 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l793
+							}
+							position++
+							goto l775
+						l793:
+							position, tokenIndex = position793, tokenIndex793
+						}
+						if !matchDot() {
+							goto l775
+						}
+					l791:
+						{
+							position792, tokenIndex792 := position, tokenIndex
+							{
+								position794, tokenIndex794 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l794
+								}
+								position++
+								goto l792
+							l794:
+								position, tokenIndex = position794, tokenIndex794
+							}
+							if !matchDot() {
+								goto l792
+							}
+							goto l791
+						l792:
+							position, tokenIndex = position792, tokenIndex792
+						}
+						add(rulePegText, position790)
+					}
+					if !_rules[ruleAction174]() {
+						goto l775
+					}
+				}
+			l777:
+				add(ruleconntrackoption, position776)
+			}
+			return true
+		l775:
+			position, tokenIndex = position775, tokenIndex775
+			return false
+		},
+		/* 28 sysctlkey <- <(<(!' ' .)+> Action175)> */
+		func() bool {
+			position795, tokenIndex795 := position, tokenIndex
+			{
+				position796 := position
+				{
+					position797 := position
+					{
+						position800, tokenIndex800 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l800
+						}
+						position++
+						goto l795
+					l800:
+						position, tokenIndex = position800, tokenIndex800
+					}
+					if !matchDot() {
+						goto l795
+					}
+				l798:
+					{
+						position799, tokenIndex799 := position, tokenIndex
+						{
+							position801, tokenIndex801 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l801
+							}
+							position++
+							goto l799
+						l801:
+							position, tokenIndex = position801, tokenIndex801
+						}
+						if !matchDot() {
+							goto l799
+						}
+						goto l798
+					l799:
+						position, tokenIndex = position799, tokenIndex799
+					}
+					add(rulePegText, position797)
+				}
+				if !_rules[ruleAction175]() {
+					goto l795
+				}
+				add(rulesysctlkey, position796)
+			}
+			return true
+		l795:
+			position, tokenIndex = position795, tokenIndex795
+			return false
+		},
+		/* 29 moveoption <- <(('n' 'a' 'm' 'e' spaces <(!' ' .)+> Action176) / ('k' 'e' 'e' 'p' 'a' 'd' 'd' 'r' Action177) / ('k' 'e' 'e' 'p' 's' 't' 'a' 't' 'e' Action178))> */
+		func() bool {
+			position802, tokenIndex802 := position, tokenIndex
+			{
+				position803 := position
+				{
+					position804, tokenIndex804 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l805
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l805
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l805
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l805
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l805
+					}
+					{
+						position806 := position
+						{
+							position809, tokenIndex809 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l809
+							}
+							position++
+							goto l805
+						l809:
+							position, tokenIndex = position809, tokenIndex809
+						}
+						if !matchDot() {
+							goto l805
+						}
+					l807:
+						{
+							position808, tokenIndex808 := position, tokenIndex
+							{
+								position810, tokenIndex810 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l810
+								}
+								position++
+								goto l808
+							l810:
+								position, tokenIndex = position810, tokenIndex810
+							}
+							if !matchDot() {
+								goto l808
+							}
+							goto l807
+						l808:
+							position, tokenIndex = position808, tokenIndex808
+						}
+						add(rulePegText, position806)
+					}
+					if !_rules[ruleAction176]() {
+						goto l805
+					}
+					goto l804
+				l805:
+					position, tokenIndex = position804, tokenIndex804
+					if buffer[position] != rune('k') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l811
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l811
+					}
+					position++
+					if !_rules[ruleAction177]() {
+						goto l811
+					}
+					goto l804
+				l811:
+					position, tokenIndex = position804, tokenIndex804
+					if buffer[position] != rune('k') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l802
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l802
+					}
+					position++
+					if !_rules[ruleAction178]() {
+						goto l802
+					}
+				}
+			l804:
+				add(rulemoveoption, position803)
+			}
+			return true
+		l802:
+			position, tokenIndex = position802, tokenIndex802
+			return false
+		},
+		/* 30 neighaddr <- <(('p' 'r' 'o' 'x' 'y' spaces <(!' ' .)+> Action179) / (<(!' ' .)+> Action180))> */
+		func() bool {
+			position812, tokenIndex812 := position, tokenIndex
+			{
+				position813 := position
+				{
+					position814, tokenIndex814 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l815
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l815
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l815
+					}
+					{
+						position816 := position
+						{
+							position819, tokenIndex819 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l819
+							}
+							position++
+							goto l815
+						l819:
+							position, tokenIndex = position819, tokenIndex819
+						}
+						if !matchDot() {
+							goto l815
+						}
+					l817:
+						{
+							position818, tokenIndex818 := position, tokenIndex
+							{
+								position820, tokenIndex820 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l820
+								}
+								position++
+								goto l818
+							l820:
+								position, tokenIndex = position820, tokenIndex820
+							}
+							if !matchDot() {
+								goto l818
+							}
+							goto l817
+						l818:
+							position, tokenIndex = position818, tokenIndex818
+						}
+						add(rulePegText, position816)
+					}
+					if !_rules[ruleAction179]() {
+						goto l815
+					}
+					goto l814
+				l815:
+					position, tokenIndex = position814, tokenIndex814
+					{
+						position821 := position
+						{
+							position824, tokenIndex824 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l824
+							}
+							position++
+							goto l812
+						l824:
+							position, tokenIndex = position824, tokenIndex824
+						}
+						if !matchDot() {
+							goto l812
+						}
+					l822:
+						{
+							position823, tokenIndex823 := position, tokenIndex
+							{
+								position825, tokenIndex825 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l825
+								}
+								position++
+								goto l823
+							l825:
+								position, tokenIndex = position825, tokenIndex825
+							}
+							if !matchDot() {
+								goto l823
+							}
+							goto l822
+						l823:
+							position, tokenIndex = position823, tokenIndex823
+						}
+						add(rulePegText, position821)
+					}
+					if !_rules[ruleAction180]() {
+						goto l812
+					}
+				}
+			l814:
+				add(ruleneighaddr, position813)
+			}
+			return true
+		l812:
+			position, tokenIndex = position812, tokenIndex812
+			return false
+		},
+		/* 31 neighoption <- <(('l' 'l' 'a' 'd' 'd' 'r' spaces <(!' ' .)+> Action181) / ('d' 'e' 'v' spaces <(!' ' .)+> Action182) / ('n' 'u' 'd' spaces <(!' ' .)+> Action183) / ('p' 'r' 'o' 'x' 'y' Action184))> */
+		func() bool {
+			position826, tokenIndex826 := position, tokenIndex
+			{
+				position827 := position
+				{
+					position828, tokenIndex828 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l829
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l829
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l829
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l829
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l829
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l829
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l829
+					}
+					{
+						position830 := position
+						{
+							position833, tokenIndex833 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l833
+							}
+							position++
+							goto l829
+						l833:
+							position, tokenIndex = position833, tokenIndex833
+						}
+						if !matchDot() {
+							goto l829
+						}
+					l831:
+						{
+							position832, tokenIndex832 := position, tokenIndex
+							{
+								position834, tokenIndex834 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l834
+								}
+								position++
+								goto l832
+							l834:
+								position, tokenIndex = position834, tokenIndex834
+							}
+							if !matchDot() {
+								goto l832
+							}
+							goto l831
+						l832:
+							position, tokenIndex = position832, tokenIndex832
+						}
+						add(rulePegText, position830)
+					}
+					if !_rules[ruleAction181]() {
+						goto l829
+					}
+					goto l828
+				l829:
+					position, tokenIndex = position828, tokenIndex828
+					if buffer[position] != rune('d') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l835
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l835
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l835
+					}
+					{
+						position836 := position
+						{
+							position839, tokenIndex839 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l839
+							}
+							position++
+							goto l835
+						l839:
+							position, tokenIndex = position839, tokenIndex839
+						}
+						if !matchDot() {
+							goto l835
+						}
+					l837:
+						{
+							position838, tokenIndex838 := position, tokenIndex
+							{
+								position840, tokenIndex840 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l840
+								}
+								position++
+								goto l838
+							l840:
+								position, tokenIndex = position840, tokenIndex840
+							}
+							if !matchDot() {
+								goto l838
+							}
+							goto l837
+						l838:
+							position, tokenIndex = position838, tokenIndex838
+						}
+						add(rulePegText, position836)
+					}
+					if !_rules[ruleAction182]() {
+						goto l835
+					}
+					goto l828
+				l835:
+					position, tokenIndex = position828, tokenIndex828
+					if buffer[position] != rune('n') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l841
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l841
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l841
+					}
+					{
+						position842 := position
+						{
+							position845, tokenIndex845 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l845
+							}
+							position++
+							goto l841
+						l845:
+							position, tokenIndex = position845, tokenIndex845
+						}
+						if !matchDot() {
+							goto l841
+						}
+					l843:
+						{
+							position844, tokenIndex844 := position, tokenIndex
+							{
+								position846, tokenIndex846 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l846
+								}
+								position++
+								goto l844
+							l846:
+								position, tokenIndex = position846, tokenIndex846
+							}
+							if !matchDot() {
+								goto l844
+							}
+							goto l843
+						l844:
+							position, tokenIndex = position844, tokenIndex844
+						}
+						add(rulePegText, position842)
+					}
+					if !_rules[ruleAction183]() {
+						goto l841
+					}
+					goto l828
+				l841:
+					position, tokenIndex = position828, tokenIndex828
+					if buffer[position] != rune('p') {
+						goto l826
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l826
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l826
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l826
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l826
+					}
+					position++
+					if !_rules[ruleAction184]() {
+						goto l826
+					}
+				}
+			l828:
+				add(ruleneighoption, position827)
+			}
+			return true
+		l826:
+			position, tokenIndex = position826, tokenIndex826
+			return false
+		},
+		/* 32 routegetoption <- <(('f' 'r' 'o' 'm' spaces <(!' ' .)+> Action185) / ('i' 'i' 'f' spaces <(!' ' .)+> Action186) / ('m' 'a' 'r' 'k' spaces <(!' ' .)+> Action187))> */
+		func() bool {
+			position847, tokenIndex847 := position, tokenIndex
+			{
+				position848 := position
+				{
+					position849, tokenIndex849 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l850
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l850
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l850
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l850
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l850
+					}
+					{
+						position851 := position
+						{
+							position854, tokenIndex854 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l854
+							}
+							position++
+							goto l850
+						l854:
+							position, tokenIndex = position854, tokenIndex854
+						}
+						if !matchDot() {
+							goto l850
+						}
+					l852:
+						{
+							position853, tokenIndex853 := position, tokenIndex
+							{
+								position855, tokenIndex855 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l855
+								}
+								position++
+								goto l853
+							l855:
+								position, tokenIndex = position855, tokenIndex855
+							}
+							if !matchDot() {
+								goto l853
+							}
+							goto l852
+						l853:
+							position, tokenIndex = position853, tokenIndex853
+						}
+						add(rulePegText, position851)
+					}
+					if !_rules[ruleAction185]() {
+						goto l850
+					}
+					goto l849
+				l850:
+					position, tokenIndex = position849, tokenIndex849
+					if buffer[position] != rune('i') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l856
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l856
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l856
+					}
+					{
+						position857 := position
+						{
+							position860, tokenIndex860 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l860
+							}
+							position++
+							goto l856
+						l860:
+							position, tokenIndex = position860, tokenIndex860
+						}
+						if !matchDot() {
+							goto l856
+						}
+					l858:
+						{
+							position859, tokenIndex859 := position, tokenIndex
+							{
+								position861, tokenIndex861 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l861
+								}
+								position++
+								goto l859
+							l861:
+								position, tokenIndex = position861, tokenIndex861
+							}
+							if !matchDot() {
+								goto l859
+							}
+							goto l858
+						l859:
+							position, tokenIndex = position859, tokenIndex859
+						}
+						add(rulePegText, position857)
+					}
+					if !_rules[ruleAction186]() {
+						goto l856
+					}
+					goto l849
+				l856:
+					position, tokenIndex = position849, tokenIndex849
+					if buffer[position] != rune('m') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l847
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l847
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l847
+					}
+					{
+						position862 := position
+						{
+							position865, tokenIndex865 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l865
+							}
+							position++
+							goto l847
+						l865:
+							position, tokenIndex = position865, tokenIndex865
+						}
+						if !matchDot() {
+							goto l847
+						}
+					l863:
+						{
+							position864, tokenIndex864 := position, tokenIndex
+							{
+								position866, tokenIndex866 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l866
+								}
+								position++
+								goto l864
+							l866:
+								position, tokenIndex = position866, tokenIndex866
+							}
+							if !matchDot() {
+								goto l864
+							}
+							goto l863
+						l864:
+							position, tokenIndex = position864, tokenIndex864
+						}
+						add(rulePegText, position862)
+					}
+					if !_rules[ruleAction187]() {
+						goto l847
+					}
+				}
+			l849:
+				add(ruleroutegetoption, position848)
+			}
+			return true
+		l847:
+			position, tokenIndex = position847, tokenIndex847
+			return false
+		},
+		/* 33 rulefamily <- <(<(('i' 'n' 'e' 't' '6') / ('i' 'n' 'e' 't'))> Action188)> */
+		func() bool {
+			position867, tokenIndex867 := position, tokenIndex
+			{
+				position868 := position
+				{
+					position869 := position
+					{
+						position870, tokenIndex870 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l871
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l871
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l871
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l871
+						}
+						position++
+						if buffer[position] != rune('6') {
+							goto l871
+						}
+						position++
+						goto l870
+					l871:
+						position, tokenIndex = position870, tokenIndex870
+						if buffer[position] != rune('i') {
+							goto l867
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l867
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l867
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l867
+						}
+						position++
+					}
+				l870:
+					add(rulePegText, position869)
+				}
+				if !_rules[ruleAction188]() {
+					goto l867
+				}
+				add(rulerulefamily, position868)
+			}
+			return true
+		l867:
+			position, tokenIndex = position867, tokenIndex867
+			return false
+		},
+		/* 34 routefamily <- <(<(('i' 'n' 'e' 't' '6') / ('i' 'n' 'e' 't') / ('a' 'l' 'l'))> Action189)> */
+		func() bool {
+			position872, tokenIndex872 := position, tokenIndex
+			{
+				position873 := position
+				{
+					position874 := position
+					{
+						position875, tokenIndex875 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l876
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l876
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l876
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l876
+						}
+						position++
+						if buffer[position] != rune('6') {
+							goto l876
+						}
+						position++
+						goto l875
+					l876:
+						position, tokenIndex = position875, tokenIndex875
+						if buffer[position] != rune('i') {
+							goto l877
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l877
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l877
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l877
+						}
+						position++
+						goto l875
+					l877:
+						position, tokenIndex = position875, tokenIndex875
+						if buffer[position] != rune('a') {
+							goto l872
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l872
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l872
+						}
+						position++
+					}
+				l875:
+					add(rulePegText, position874)
+				}
+				if !_rules[ruleAction189]() {
+					goto l872
+				}
+				add(ruleroutefamily, position873)
+			}
+			return true
+		l872:
+			position, tokenIndex = position872, tokenIndex872
+			return false
+		},
+		/* 35 ruleoption <- <(rulefamily / ('n' 'o' 't' Action190) / ('f' 'r' 'o' 'm' spaces <(!' ' .)+> Action191) / ('t' 'o' spaces <(!' ' .)+> Action192) / ('i' 'i' 'f' spaces <(!' ' .)+> Action193) / ('o' 'i' 'f' spaces <(!' ' .)+> Action194) / ('f' 'w' 'm' 'a' 'r' 'k' spaces <(!' ' .)+> Action195) / ('t' 'a' 'b' 'l' 'e' spaces <(!' ' .)+> Action196) / ('p' 'r' 'i' 'o' 'r' 'i' 't' 'y' spaces <(!' ' .)+> Action197))> */
+		func() bool {
+			position878, tokenIndex878 := position, tokenIndex
+			{
+				position879 := position
+				{
+					position880, tokenIndex880 := position, tokenIndex
+					if !_rules[rulerulefamily]() {
+						goto l881
+					}
+					goto l880
+				l881:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('n') {
+						goto l882
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l882
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l882
+					}
+					position++
+					if !_rules[ruleAction190]() {
+						goto l882
+					}
+					goto l880
+				l882:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('f') {
+						goto l883
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l883
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l883
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l883
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l883
+					}
+					{
+						position884 := position
+						{
+							position887, tokenIndex887 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l887
+							}
+							position++
+							goto l883
+						l887:
+							position, tokenIndex = position887, tokenIndex887
+						}
+						if !matchDot() {
+							goto l883
+						}
+					l885:
+						{
+							position886, tokenIndex886 := position, tokenIndex
+							{
+								position888, tokenIndex888 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l888
+								}
+								position++
+								goto l886
+							l888:
+								position, tokenIndex = position888, tokenIndex888
+							}
+							if !matchDot() {
+								goto l886
+							}
+							goto l885
+						l886:
+							position, tokenIndex = position886, tokenIndex886
+						}
+						add(rulePegText, position884)
+					}
+					if !_rules[ruleAction191]() {
+						goto l883
+					}
+					goto l880
+				l883:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('t') {
+						goto l889
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l889
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l889
+					}
+					{
+						position890 := position
+						{
+							position893, tokenIndex893 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l893
+							}
+							position++
+							goto l889
+						l893:
+							position, tokenIndex = position893, tokenIndex893
+						}
+						if !matchDot() {
+							goto l889
+						}
+					l891:
+						{
+							position892, tokenIndex892 := position, tokenIndex
+							{
+								position894, tokenIndex894 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l894
+								}
+								position++
+								goto l892
+							l894:
+								position, tokenIndex = position894, tokenIndex894
+							}
+							if !matchDot() {
+								goto l892
+							}
+							goto l891
+						l892:
+							position, tokenIndex = position892, tokenIndex892
+						}
+						add(rulePegText, position890)
+					}
+					if !_rules[ruleAction192]() {
+						goto l889
+					}
+					goto l880
+				l889:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('i') {
+						goto l895
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l895
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l895
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l895
+					}
+					{
+						position896 := position
+						{
+							position899, tokenIndex899 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l899
+							}
+							position++
+							goto l895
+						l899:
+							position, tokenIndex = position899, tokenIndex899
+						}
+						if !matchDot() {
+							goto l895
+						}
+					l897:
+						{
+							position898, tokenIndex898 := position, tokenIndex
+							{
+								position900, tokenIndex900 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l900
+								}
+								position++
+								goto l898
+							l900:
+								position, tokenIndex = position900, tokenIndex900
+							}
+							if !matchDot() {
+								goto l898
+							}
+							goto l897
+						l898:
+							position, tokenIndex = position898, tokenIndex898
+						}
+						add(rulePegText, position896)
+					}
+					if !_rules[ruleAction193]() {
+						goto l895
+					}
+					goto l880
+				l895:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('o') {
+						goto l901
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l901
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l901
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l901
+					}
+					{
+						position902 := position
+						{
+							position905, tokenIndex905 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l905
+							}
+							position++
+							goto l901
+						l905:
+							position, tokenIndex = position905, tokenIndex905
+						}
+						if !matchDot() {
+							goto l901
+						}
+					l903:
+						{
+							position904, tokenIndex904 := position, tokenIndex
+							{
+								position906, tokenIndex906 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l906
+								}
+								position++
+								goto l904
+							l906:
+								position, tokenIndex = position906, tokenIndex906
+							}
+							if !matchDot() {
+								goto l904
+							}
+							goto l903
+						l904:
+							position, tokenIndex = position904, tokenIndex904
+						}
+						add(rulePegText, position902)
+					}
+					if !_rules[ruleAction194]() {
+						goto l901
+					}
+					goto l880
+				l901:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('f') {
+						goto l907
+					}
+					position++
+					if buffer[position] != rune('w') {
+						goto l907
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l907
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l907
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l907
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l907
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l907
+					}
+					{
+						position908 := position
+						{
+							position911, tokenIndex911 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l911
+							}
+							position++
+							goto l907
+						l911:
+							position, tokenIndex = position911, tokenIndex911
+						}
+						if !matchDot() {
+							goto l907
+						}
+					l909:
+						{
+							position910, tokenIndex910 := position, tokenIndex
+							{
+								position912, tokenIndex912 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l912
+								}
+								position++
+								goto l910
+							l912:
+								position, tokenIndex = position912, tokenIndex912
+							}
+							if !matchDot() {
+								goto l910
+							}
+							goto l909
+						l910:
+							position, tokenIndex = position910, tokenIndex910
+						}
+						add(rulePegText, position908)
+					}
+					if !_rules[ruleAction195]() {
+						goto l907
+					}
+					goto l880
+				l907:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('t') {
+						goto l913
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l913
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l913
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l913
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l913
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l913
+					}
+					{
+						position914 := position
+						{
+							position917, tokenIndex917 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l917
+							}
+							position++
+							goto l913
+						l917:
+							position, tokenIndex = position917, tokenIndex917
+						}
+						if !matchDot() {
+							goto l913
+						}
+					l915:
+						{
+							position916, tokenIndex916 := position, tokenIndex
+							{
+								position918, tokenIndex918 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l918
+								}
+								position++
+								goto l916
+							l918:
+								position, tokenIndex = position918, tokenIndex918
+							}
+							if !matchDot() {
+								goto l916
+							}
+							goto l915
+						l916:
+							position, tokenIndex = position916, tokenIndex916
+						}
+						add(rulePegText, position914)
+					}
+					if !_rules[ruleAction196]() {
+						goto l913
+					}
+					goto l880
+				l913:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('p') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l878
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l878
+					}
+					position++
+					if !_rules[rulespaces]() {
+						goto l878
+					}
+					{
+						position919 := position
+						{
+							position922, tokenIndex922 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l922
+							}
+							position++
+							goto l878
+						l922:
+							position, tokenIndex = position922, tokenIndex922
+						}
+						if !matchDot() {
+							goto l878
+						}
+					l920:
+						{
+							position921, tokenIndex921 := position, tokenIndex
+							{
+								position923, tokenIndex923 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l923
+								}
+								position++
+								goto l921
+							l923:
+								position, tokenIndex = position923, tokenIndex923
+							}
+							if !matchDot() {
+								goto l921
+							}
+							goto l920
+						l921:
+							position, tokenIndex = position921, tokenIndex921
+						}
+						add(rulePegText, position919)
+					}
+					if !_rules[ruleAction197]() {
+						goto l878
+					}
+				}
+			l880:
+				add(ruleruleoption, position879)
+			}
+			return true
+		l878:
+			position, tokenIndex = position878, tokenIndex878
+			return false
+		},
+		/* 36 spaces <- <(' ' / '\t')*> */
+		func() bool {
+			{
+				position925 := position
+			l926:
+				{
+					position927, tokenIndex927 := position, tokenIndex
+					{
+						position928, tokenIndex928 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l929
+						}
+						position++
+						goto l928
+					l929:
+						position, tokenIndex = position928, tokenIndex928
+						if buffer[position] != rune('\t') {
+							goto l927
+						}
+						position++
+					}
+				l928:
+					goto l926
+				l927:
+					position, tokenIndex = position927, tokenIndex927
+				}
+				add(rulespaces, position925)
+			}
+			return true
+		},
+		nil,
+		/* 39 Action0 <- <{ p.TargetType = NSNONE }> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 40 Action1 <- <{p.Err(begin, buffer, "veth takes namespace of each end")}> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 41 Action2 <- <{p.Err(begin, buffer, "")}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 42 Action3 <- <{p.Err(begin, buffer, "")}> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 43 Action4 <- <{p.IgnoreExisting = true}> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 44 Action5 <- <{p.IgnoreMissing = true}> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 45 Action6 <- <{p.FlushConntrack = true}> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 46 Action7 <- <{p.TargetType = DOCKER}> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 47 Action8 <- <{p.TargetType = NETNS}> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 48 Action9 <- <{p.TargetType = IPNETNS}> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 49 Action10 <- <{p.TargetType = PID}> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 50 Action11 <- <{p.Err(begin, buffer, "Invalid namespace")}> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 51 Action12 <- <{p.Target = text}> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 52 Action13 <- <{p.Operation = ROUTEADD}> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 53 Action14 <- <{p.Operation = ROUTEDEL}> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 54 Action15 <- <{p.Operation = ROUTEREPLACE}> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 55 Action16 <- <{p.Operation = ROUTECHANGE}> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 56 Action17 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 57 Action18 <- <{p.Err(begin, buffer, "invalid network")}> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 58 Action19 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 59 Action20 <- <{p.Err(begin, buffer, "Invalid network")}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 60 Action21 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 61 Action22 <- <{p.Err(begin, buffer, "Invalid network")}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 62 Action23 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 63 Action24 <- <{p.Err(begin, buffer, "Invalid network")}> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 64 Action25 <- <{p.Operation = ROUTEFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 65 Action26 <- <{p.Operation = ROUTESHOW}> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 66 Action27 <- <{p.SetOption("to", text)}> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 67 Action28 <- <{p.Operation = ROUTEGET}> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 68 Action29 <- <{p.Err(begin, buffer, "")}> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 69 Action30 <- <{p.Operation = ADDRADD}> */
+		func() bool {
+			{
+				add(ruleAction30, position)
+			}
+			return true
+		},
+		/* 70 Action31 <- <{p.Operation = ADDRDEL}> */
+		func() bool {
+			{
+				add(ruleAction31, position)
+			}
+			return true
+		},
+		/* 71 Action32 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction32, position)
+			}
+			return true
+		},
+		/* 72 Action33 <- <{p.Err(begin, buffer, "Invalid address")}> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 73 Action34 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 74 Action35 <- <{p.Err(begin, buffer, "Invalid address")}> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 75 Action36 <- <{p.Operation = ADDRFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction36, position)
+			}
+			return true
+		},
+		/* 76 Action37 <- <{p.Operation = RULEADD}> */
+		func() bool {
+			{
+				add(ruleAction37, position)
+			}
+			return true
+		},
+		/* 77 Action38 <- <{p.Operation = RULEDEL}> */
+		func() bool {
+			{
+				add(ruleAction38, position)
+			}
+			return true
+		},
+		/* 78 Action39 <- <{p.Operation = RULESHOW}> */
+		func() bool {
+			{
+				add(ruleAction39, position)
+			}
+			return true
+		},
+		/* 79 Action40 <- <{p.Err(begin, buffer, "Invalid rule")}> */
+		func() bool {
+			{
+				add(ruleAction40, position)
+			}
+			return true
+		},
+		/* 80 Action41 <- <{p.Operation = VETHADD}> */
+		func() bool {
+			{
+				add(ruleAction41, position)
+			}
+			return true
+		},
+		/* 81 Action42 <- <{p.Operation = LINKADD}> */
+		func() bool {
+			{
+				add(ruleAction42, position)
+			}
+			return true
+		},
+		/* 82 Action43 <- <{p.Operation = LINKSET}> */
+		func() bool {
+			{
+				add(ruleAction43, position)
+			}
+			return true
+		},
+		/* 83 Action44 <- <{p.Operation = LINKSHOW}> */
+		func() bool {
+			{
+				add(ruleAction44, position)
+			}
+			return true
+		},
+		/* 84 Action45 <- <{p.Operation = LINKADOPT}> */
+		func() bool {
+			{
+				add(ruleAction45, position)
+			}
+			return true
+		},
+		/* 85 Action46 <- <{p.Operation = LINKRELEASE}> */
+		func() bool {
+			{
+				add(ruleAction46, position)
+			}
+			return true
+		},
+		/* 86 Action47 <- <{p.Err(begin, buffer, "Invalid link")}> */
+		func() bool {
+			{
+				add(ruleAction47, position)
+			}
+			return true
+		},
+		/* 87 Action48 <- <{p.Operation = NEIGHADD}> */
+		func() bool {
+			{
+				add(ruleAction48, position)
+			}
+			return true
+		},
+		/* 88 Action49 <- <{p.Operation = NEIGHDEL}> */
+		func() bool {
+			{
+				add(ruleAction49, position)
+			}
+			return true
+		},
+		/* 89 Action50 <- <{p.Operation = NEIGHREPLACE}> */
+		func() bool {
+			{
+				add(ruleAction50, position)
+			}
+			return true
+		},
+		/* 90 Action51 <- <{p.Operation = NEIGHSHOW}> */
+		func() bool {
+			{
+				add(ruleAction51, position)
+			}
+			return true
+		},
+		/* 91 Action52 <- <{p.Operation = NEIGHFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction52, position)
+			}
+			return true
+		},
+		/* 92 Action53 <- <{p.Err(begin, buffer, "Invalid neighbor")}> */
+		func() bool {
+			{
+				add(ruleAction53, position)
+			}
+			return true
+		},
+		/* 93 Action54 <- <{p.Operation = VRFSHOW}> */
+		func() bool {
+			{
+				add(ruleAction54, position)
+			}
+			return true
+		},
+		/* 94 Action55 <- <{p.Operation = QDISCADD}> */
+		func() bool {
+			{
+				add(ruleAction55, position)
+			}
+			return true
+		},
+		/* 95 Action56 <- <{p.Operation = QDISCREPLACE}> */
+		func() bool {
+			{
+				add(ruleAction56, position)
+			}
+			return true
+		},
+		/* 96 Action57 <- <{p.Operation = QDISCDEL}> */
+		func() bool {
+			{
+				add(ruleAction57, position)
+			}
+			return true
+		},
+		/* 97 Action58 <- <{p.Operation = QDISCSHOW}> */
+		func() bool {
+			{
+				add(ruleAction58, position)
+			}
+			return true
+		},
+		/* 98 Action59 <- <{p.Err(begin, buffer, "Invalid qdisc")}> */
+		func() bool {
+			{
+				add(ruleAction59, position)
+			}
+			return true
+		},
+		/* 99 Action60 <- <{p.Operation = CLASSADD}> */
+		func() bool {
+			{
+				add(ruleAction60, position)
+			}
+			return true
+		},
+		/* 100 Action61 <- <{p.Operation = CLASSREPLACE}> */
+		func() bool {
+			{
+				add(ruleAction61, position)
+			}
+			return true
+		},
+		/* 101 Action62 <- <{p.Operation = CLASSDEL}> */
+		func() bool {
+			{
+				add(ruleAction62, position)
+			}
+			return true
+		},
+		/* 102 Action63 <- <{p.Operation = CLASSSHOW}> */
+		func() bool {
+			{
+				add(ruleAction63, position)
+			}
+			return true
+		},
+		/* 103 Action64 <- <{p.Err(begin, buffer, "Invalid class")}> */
+		func() bool {
+			{
+				add(ruleAction64, position)
+			}
+			return true
+		},
+		/* 104 Action65 <- <{p.Operation = NATMASQUERADE}> */
+		func() bool {
+			{
+				add(ruleAction65, position)
+			}
+			return true
+		},
+		/* 105 Action66 <- <{p.Operation = NATSNAT}> */
+		func() bool {
+			{
+				add(ruleAction66, position)
+			}
+			return true
+		},
+		/* 106 Action67 <- <{p.Operation = NATDNAT}> */
+		func() bool {
+			{
+				add(ruleAction67, position)
+			}
+			return true
+		},
+		/* 107 Action68 <- <{p.Operation = NATSHOW}> */
+		func() bool {
+			{
+				add(ruleAction68, position)
+			}
+			return true
+		},
+		/* 108 Action69 <- <{p.Operation = NATFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction69, position)
+			}
+			return true
+		},
+		/* 109 Action70 <- <{p.Err(begin, buffer, "Invalid nat")}> */
+		func() bool {
+			{
+				add(ruleAction70, position)
+			}
+			return true
+		},
+		/* 110 Action71 <- <{p.Operation = FILTERSHOW}> */
+		func() bool {
+			{
+				add(ruleAction71, position)
+			}
+			return true
+		},
+		/* 111 Action72 <- <{p.Operation = FILTERFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction72, position)
+			}
+			return true
+		},
+		/* 112 Action73 <- <{p.Operation = FILTERADD}> */
+		func() bool {
+			{
+				add(ruleAction73, position)
+			}
+			return true
+		},
+		/* 113 Action74 <- <{p.Err(begin, buffer, "Invalid filter")}> */
+		func() bool {
+			{
+				add(ruleAction74, position)
+			}
+			return true
+		},
+		/* 114 Action75 <- <{p.Operation = CONNTRACKSHOW}> */
+		func() bool {
+			{
+				add(ruleAction75, position)
+			}
+			return true
+		},
+		/* 115 Action76 <- <{p.Operation = CONNTRACKFLUSH}> */
+		func() bool {
+			{
+				add(ruleAction76, position)
+			}
+			return true
+		},
+		/* 116 Action77 <- <{p.Err(begin, buffer, "Invalid conntrack")}> */
+		func() bool {
+			{
+				add(ruleAction77, position)
+			}
+			return true
+		},
+		/* 117 Action78 <- <{p.Operation = SYSCTLGET}> */
+		func() bool {
+			{
+				add(ruleAction78, position)
+			}
+			return true
+		},
+		/* 118 Action79 <- <{p.SetOption("value", text)}> */
+		func() bool {
+			{
+				add(ruleAction79, position)
+			}
+			return true
+		},
+		/* 119 Action80 <- <{p.Operation = SYSCTLSET}> */
+		func() bool {
+			{
+				add(ruleAction80, position)
+			}
+			return true
+		},
+		/* 120 Action81 <- <{p.Err(begin, buffer, "Invalid sysctl")}> */
+		func() bool {
+			{
+				add(ruleAction81, position)
+			}
+			return true
+		},
+		/* 121 Action82 <- <{p.Err(begin, buffer, "Invalid vrf")}> */
+		func() bool {
+			{
+				add(ruleAction82, position)
+			}
+			return true
+		},
+		/* 122 Action83 <- <{p.SetOption("mpls", text)}> */
+		func() bool {
+			{
+				add(ruleAction83, position)
+			}
+			return true
+		},
+		/* 123 Action84 <- <{p.IsDefault = true}> */
+		func() bool {
+			{
+				add(ruleAction84, position)
+			}
+			return true
+		},
+		/* 124 Action85 <- <{p.IsDefault = false}> */
+		func() bool {
+			{
+				add(ruleAction85, position)
+			}
+			return true
+		},
+		/* 125 Action86 <- <{p.IsDefault = false}> */
+		func() bool {
+			{
+				add(ruleAction86, position)
+			}
+			return true
+		},
+		/* 126 Action87 <- <{p.Network = text}> */
+		func() bool {
+			{
+				add(ruleAction87, position)
+			}
+			return true
+		},
+		/* 127 Action88 <- <{p.NetworkLength = text}> */
+		func() bool {
+			{
+				add(ruleAction88, position)
+			}
+			return true
+		},
+		/* 128 Action89 <- <{p.IsViaInet6 = true; p.SetOption("via", text)}> */
+		func() bool {
+			{
+				add(ruleAction89, position)
+			}
+			return true
+		},
+		/* 129 Action90 <- <{p.SetOption("via", text)}> */
+		func() bool {
+			{
+				add(ruleAction90, position)
+			}
+			return true
+		},
+		/* 130 Action91 <- <{p.SetOption("dev", text)}> */
+		func() bool {
+			{
+				add(ruleAction91, position)
+			}
+			return true
+		},
+		/* 131 Action92 <- <{p.SetOption("table", text)}> */
+		func() bool {
+			{
+				add(ruleAction92, position)
+			}
+			return true
+		},
+		/* 132 Action93 <- <{p.SetOption("proto", text)}> */
+		func() bool {
+			{
+				add(ruleAction93, position)
+			}
+			return true
+		},
+		/* 133 Action94 <- <{p.SetOption("scope", text)}> */
+		func() bool {
+			{
+				add(ruleAction94, position)
+			}
+			return true
+		},
+		/* 134 Action95 <- <{p.SetOption("vrf", text)}> */
+		func() bool {
+			{
+				add(ruleAction95, position)
+			}
+			return true
+		},
+		/* 135 Action96 <- <{p.IsOnlink = true}> */
+		func() bool {
+			{
+				add(ruleAction96, position)
+			}
+			return true
+		},
+		/* 136 Action97 <- <{p.SetOption("pref", text)}> */
+		func() bool {
+			{
+				add(ruleAction97, position)
+			}
+			return true
+		},
+		/* 137 Action98 <- <{p.SetOption("expires", text)}> */
+		func() bool {
+			{
+				add(ruleAction98, position)
+			}
+			return true
+		},
+		/* 138 Action99 <- <{p.SetOption("as", text)}> */
+		func() bool {
+			{
+				add(ruleAction99, position)
+			}
+			return true
+		},
+		/* 139 Action100 <- <{p.SetOption("encap", "mpls"); p.SetOption("labels", text)}> */
+		func() bool {
+			{
+				add(ruleAction100, position)
+			}
+			return true
+		},
+		/* 140 Action101 <- <{p.SetOption("encap", "seg6local"); p.SetOption("action", text)}> */
+		func() bool {
+			{
+				add(ruleAction101, position)
+			}
+			return true
+		},
+		/* 141 Action102 <- <{p.SetOption("encap", "seg6"); p.SetOption("seg6mode", text)}> */
+		func() bool {
+			{
+				add(ruleAction102, position)
+			}
+			return true
+		},
+		/* 142 Action103 <- <{p.SetOption("segs", text)}> */
+		func() bool {
+			{
+				add(ruleAction103, position)
+			}
+			return true
+		},
+		/* 143 Action104 <- <{p.SetOption("nh6", text)}> */
+		func() bool {
+			{
+				add(ruleAction104, position)
+			}
+			return true
+		},
+		/* 144 Action105 <- <{p.SetOption("localtable", text)}> */
+		func() bool {
+			{
+				add(ruleAction105, position)
+			}
+			return true
+		},
+		/* 145 Action106 <- <{p.SetOption("vrftable", text)}> */
+		func() bool {
+			{
+				add(ruleAction106, position)
+			}
+			return true
+		},
+		/* 146 Action107 <- <{p.SetOption("dev", text)}> */
+		func() bool {
+			{
+				add(ruleAction107, position)
+			}
+			return true
+		},
+		/* 147 Action108 <- <{p.SetOption("peer", text)}> */
+		func() bool {
+			{
+				add(ruleAction108, position)
+			}
+			return true
+		},
+		/* 148 Action109 <- <{p.SetOption("broadcast", text)}> */
+		func() bool {
+			{
+				add(ruleAction109, position)
+			}
+			return true
+		},
+		/* 149 Action110 <- <{p.SetOption("label", text)}> */
+		func() bool {
+			{
+				add(ruleAction110, position)
+			}
+			return true
+		},
+		/* 150 Action111 <- <{p.SetOption("scope", text)}> */
+		func() bool {
+			{
+				add(ruleAction111, position)
+			}
+			return true
+		},
+		/* 151 Action112 <- <{p.SetOption("valid_lft", text)}> */
+		func() bool {
+			{
+				add(ruleAction112, position)
+			}
+			return true
+		},
+		/* 152 Action113 <- <{p.SetOption("preferred_lft", text)}> */
+		func() bool {
+			{
+				add(ruleAction113, position)
+			}
+			return true
+		},
+		/* 153 Action114 <- <{p.IsNodad = true}> */
+		func() bool {
+			{
+				add(ruleAction114, position)
+			}
+			return true
+		},
+		/* 154 Action115 <- <{p.IsNoprefixroute = true}> */
+		func() bool {
+			{
+				add(ruleAction115, position)
+			}
+			return true
+		},
+		/* 155 Action116 <- <{p.IsHome = true}> */
+		func() bool {
+			{
+				add(ruleAction116, position)
+			}
+			return true
+		},
+		/* 156 Action117 <- <{p.IsMngtmpaddr = true}> */
+		func() bool {
+			{
+				add(ruleAction117, position)
+			}
+			return true
+		},
+		/* 157 Action118 <- <{p.Veth[0].Name = text}> */
+		func() bool {
+			{
+				add(ruleAction118, position)
+			}
+			return true
+		},
+		/* 158 Action119 <- <{p.SetVethNS(0)}> */
+		func() bool {
+			{
+				add(ruleAction119, position)
+			}
+			return true
+		},
+		/* 159 Action120 <- <{p.Veth[1].Name = text}> */
+		func() bool {
+			{
+				add(ruleAction120, position)
+			}
+			return true
+		},
+		/* 160 Action121 <- <{p.SetVethNS(1)}> */
+		func() bool {
+			{
+				add(ruleAction121, position)
+			}
+			return true
+		},
+		/* 161 Action122 <- <{p.Veth[0].Address = text}> */
+		func() bool {
+			{
+				add(ruleAction122, position)
+			}
+			return true
+		},
+		/* 162 Action123 <- <{p.Veth[1].Address = text}> */
+		func() bool {
+			{
+				add(ruleAction123, position)
+			}
+			return true
+		},
+		/* 163 Action124 <- <{p.SetOption("dev", text)}> */
+		func() bool {
+			{
+				add(ruleAction124, position)
+			}
+			return true
+		},
+		/* 164 Action125 <- <{p.SetOption("type", text)}> */
+		func() bool {
+			{
+				add(ruleAction125, position)
+			}
+			return true
+		},
+		/* 165 Action126 <- <{p.SetOption("parent", text)}> */
+		func() bool {
+			{
+				add(ruleAction126, position)
+			}
+			return true
+		},
+		/* 166 Action127 <- <{p.SetOption("parent", text)}> */
+		func() bool {
+			{
+				add(ruleAction127, position)
+			}
+			return true
+		},
+		/* 167 Action128 <- <{p.SetOption("local", text)}> */
+		func() bool {
+			{
+				add(ruleAction128, position)
+			}
+			return true
+		},
+		/* 168 Action129 <- <{p.SetOption("remote", text)}> */
+		func() bool {
+			{
+				add(ruleAction129, position)
+			}
+			return true
+		},
+		/* 169 Action130 <- <{p.SetOption("dstport", text)}> */
+		func() bool {
+			{
+				add(ruleAction130, position)
+			}
+			return true
+		},
+		/* 170 Action131 <- <{p.SetOption("stp", text)}> */
+		func() bool {
+			{
+				add(ruleAction131, position)
+			}
+			return true
+		},
+		/* 171 Action132 <- <{p.SetOption("vlan_filtering", text)}> */
+		func() bool {
+			{
+				add(ruleAction132, position)
+			}
+			return true
+		},
+		/* 172 Action133 <- <{p.SetOption("miimon", text)}> */
+		func() bool {
+			{
+				add(ruleAction133, position)
+			}
+			return true
+		},
+		/* 173 Action134 <- <{p.SetOption("table", text)}> */
+		func() bool {
+			{
+				add(ruleAction134, position)
+			}
+			return true
+		},
+		/* 174 Action135 <- <{p.SetOption("id", text)}> */
+		func() bool {
+			{
+				add(ruleAction135, position)
+			}
+			return true
+		},
+		/* 175 Action136 <- <{p.SetOption("mode", text)}> */
+		func() bool {
+			{
+				add(ruleAction136, position)
+			}
+			return true
+		},
+		/* 176 Action137 <- <{p.SetOption("name", text)}> */
+		func() bool {
+			{
+				add(ruleAction137, position)
+			}
+			return true
+		},
+		/* 177 Action138 <- <{p.SetOption("state", "up")}> */
+		func() bool {
+			{
+				add(ruleAction138, position)
+			}
+			return true
+		},
+		/* 178 Action139 <- <{p.SetOption("state", "up")}> */
+		func() bool {
+			{
+				add(ruleAction139, position)
+			}
+			return true
+		},
+		/* 179 Action140 <- <{p.SetOption("state", "down")}> */
+		func() bool {
+			{
+				add(ruleAction140, position)
+			}
+			return true
+		},
+		/* 180 Action141 <- <{p.SetOption("mtu", text)}> */
+		func() bool {
+			{
+				add(ruleAction141, position)
 			}
 			return true
-		l82:
-			position, tokenIndex = position82, tokenIndex82
-			return false
 		},
-		/* 7 len <- <(<[0-9]+> Action24)> */
+		/* 181 Action142 <- <{p.SetOption("lladdr", text)}> */
 		func() bool {
-			position97, tokenIndex97 := position, tokenIndex
 			{
-				position98 := position
-				{
-					position99 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l97
-					}
-					position++
-				l100:
-					{
-						position101, tokenIndex101 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l101
-						}
-						position++
-						goto l100
-					l101:
-						position, tokenIndex = position101, tokenIndex101
-					}
-					add(rulePegText, position99)
-				}
-				if !_rules[ruleAction24]() {
-					goto l97
-				}
-				add(rulelen, position98)
+				add(ruleAction142, position)
 			}
 			return true
-		l97:
-			position, tokenIndex = position97, tokenIndex97
-			return false
 		},
-		/* 8 option <- <(('v' 'i' 'a' spaces <(!' ' .)+> Action25) / ('d' 'e' 'v' spaces <(!' ' .)+> Action26))> */
+		/* 182 Action143 <- <{p.SetOption("name", text)}> */
 		func() bool {
-			position102, tokenIndex102 := position, tokenIndex
 			{
-				position103 := position
-				{
-					position104, tokenIndex104 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l105
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l105
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l105
-					}
-					position++
-					if !_rules[rulespaces]() {
-						goto l105
-					}
-					{
-						position106 := position
-						{
-							position109, tokenIndex109 := position, tokenIndex
-							if buffer[position] != rune(' ') {
-								goto l109
-							}
-							position++
-							goto l105
-						l109:
-							position, tokenIndex = position109, tokenIndex109
-						}
-						if !matchDot() {
-							goto l105
-						}
-					l107:
-						{
-							position108, tokenIndex108 := position, tokenIndex
-							{
-								position110, tokenIndex110 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l110
-								}
-								position++
-								goto l108
-							l110:
-								position, tokenIndex = position110, tokenIndex110
-							}
-							if !matchDot() {
-								goto l108
-							}
-							goto l107
-						l108:
-							position, tokenIndex = position108, tokenIndex108
-						}
-						add(rulePegText, position106)
-					}
-					if !_rules[ruleAction25]() {
-						goto l105
-					}
-					goto l104
-				l105:
-					position, tokenIndex = position104, tokenIndex104
-					if buffer[position] != rune('d') {
-						goto l102
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l102
-					}
-					position++
-					if buffer[position] != rune('v') {
-						goto l102
-					}
-					position++
-					if !_rules[rulespaces]() {
-						goto l102
-					}
-					{
-						position111 := position
-						{
-							position114, tokenIndex114 := position, tokenIndex
-							if buffer[position] != rune(' ') {
-								goto l114
-							}
-							position++
-							goto l102
-						l114:
-							position, tokenIndex = position114, tokenIndex114
-						}
-						if !matchDot() {
-							goto l102
-						}
-					l112:
-						{
-							position113, tokenIndex113 := position, tokenIndex
-							{
-								position115, tokenIndex115 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l115
-								}
-								position++
-								goto l113
-							l115:
-								position, tokenIndex = position115, tokenIndex115
-							}
-							if !matchDot() {
-								goto l113
-							}
-							goto l112
-						l113:
-							position, tokenIndex = position113, tokenIndex113
-						}
-						add(rulePegText, position111)
-					}
-					if !_rules[ruleAction26]() {
-						goto l102
-					}
-				}
-			l104:
-				add(ruleoption, position103)
+				add(ruleAction143, position)
 			}
 			return true
-		l102:
-			position, tokenIndex = position102, tokenIndex102
-			return false
 		},
-		/* 9 spaces <- <(' ' / '\t')*> */
+		/* 183 Action144 <- <{p.SetOption("txqueuelen", text)}> */
 		func() bool {
 			{
-				position117 := position
-			l118:
-				{
-					position119, tokenIndex119 := position, tokenIndex
-					{
-						position120, tokenIndex120 := position, tokenIndex
-						if buffer[position] != rune(' ') {
-							goto l121
-						}
-						position++
-						goto l120
-					l121:
-						position, tokenIndex = position120, tokenIndex120
-						if buffer[position] != rune('\t') {
-							goto l119
-						}
-						position++
-					}
-				l120:
-					goto l118
-				l119:
-					position, tokenIndex = position119, tokenIndex119
-				}
-				add(rulespaces, position117)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		nil,
-		/* 12 Action0 <- <{p.Err(begin, buffer, "")}> */
+		/* 184 Action145 <- <{p.SetOption("alias", text)}> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 13 Action1 <- <{ p.TargetType = NSNONE }> */
+		/* 185 Action146 <- <{p.SetOption("master", text)}> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 14 Action2 <- <{p.Err(begin, buffer, "")}> */
+		/* 186 Action147 <- <{p.IsNomaster = true}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 15 Action3 <- <{p.TargetType = DOCKER}> */
+		/* 187 Action148 <- <{p.SetOption("type", text)}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 16 Action4 <- <{p.TargetType = NETNS}> */
+		/* 188 Action149 <- <{p.SetOption("dev", text)}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction149, position)
 			}
 			return true
 		},
-		/* 17 Action5 <- <{p.TargetType = PID}> */
+		/* 189 Action150 <- <{p.SetOption("parent", text)}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction150, position)
 			}
 			return true
 		},
-		/* 18 Action6 <- <{p.Err(begin, buffer, "Invalid namespace")}> */
+		/* 190 Action151 <- <{p.SetOption("handle", text)}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction151, position)
 			}
 			return true
 		},
-		/* 19 Action7 <- <{p.Target = text}> */
+		/* 191 Action152 <- <{p.SetOption("classid", text)}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction152, position)
 			}
 			return true
 		},
-		/* 20 Action8 <- <{p.Operation = ROUTEADD}> */
+		/* 192 Action153 <- <{p.SetOption("delay", text)}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction153, position)
 			}
 			return true
 		},
-		/* 21 Action9 <- <{p.Operation = ROUTEDEL}> */
+		/* 193 Action154 <- <{p.SetOption("jitter", text)}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction154, position)
 			}
 			return true
 		},
-		/* 22 Action10 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		/* 194 Action155 <- <{p.SetOption("loss", text)}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction155, position)
 			}
 			return true
 		},
-		/* 23 Action11 <- <{p.Err(begin, buffer, "invalid network")}> */
+		/* 195 Action156 <- <{p.SetOption("duplicate", text)}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction156, position)
 			}
 			return true
 		},
-		/* 24 Action12 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		/* 196 Action157 <- <{p.SetOption("rate", text)}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction157, position)
 			}
 			return true
 		},
-		/* 25 Action13 <- <{p.Err(begin, buffer, "Invalid network")}> */
+		/* 197 Action158 <- <{p.SetOption("ceil", text)}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction158, position)
 			}
 			return true
 		},
-		/* 26 Action14 <- <{p.Err(begin, buffer, "")}> */
+		/* 198 Action159 <- <{p.SetOption("burst", text)}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 27 Action15 <- <{p.Operation = ADDRADD}> */
+		/* 199 Action160 <- <{p.SetOption("latency", text)}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 28 Action16 <- <{p.Operation = ADDRDEL}> */
+		/* 200 Action161 <- <{p.SetOption("default", text)}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 29 Action17 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		/* 201 Action162 <- <{p.SetOption("parent", "root")}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 30 Action18 <- <{p.Err(begin, buffer, "Invalid address")}> */
+		/* 202 Action163 <- <{p.SetOption("chain", text)}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 31 Action19 <- <{p.Err(begin, buffer, "Invalid option")}> */
+		/* 203 Action164 <- <{p.SetOption("verdict", text)}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction164, position)
 			}
 			return true
 		},
-		/* 32 Action20 <- <{p.Err(begin, buffer, "Invalid address")}> */
+		/* 204 Action165 <- <{p.SetOption("src", text)}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction165, position)
 			}
 			return true
 		},
-		/* 33 Action21 <- <{p.IsDefault = false}> */
+		/* 205 Action166 <- <{p.SetOption("dst", text)}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction166, position)
 			}
 			return true
 		},
-		/* 34 Action22 <- <{p.IsDefault = true}> */
+		/* 206 Action167 <- <{p.SetOption("iif", text)}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction167, position)
 			}
 			return true
 		},
-		/* 35 Action23 <- <{p.Network = text}> */
+		/* 207 Action168 <- <{p.SetOption("oif", text)}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction168, position)
 			}
 			return true
 		},
-		/* 36 Action24 <- <{p.NetworkLength = text}> */
+		/* 208 Action169 <- <{p.SetOption("proto", text)}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction169, position)
 			}
 			return true
 		},
-		/* 37 Action25 <- <{p.SetOption("via", text)}> */
+		/* 209 Action170 <- <{p.SetOption("dport", text)}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction170, position)
 			}
 			return true
 		},
-		/* 38 Action26 <- <{p.SetOption("dev", text)}> */
+		/* 210 Action171 <- <{p.SetOption("to", text)}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction171, position)
+			}
+			return true
+		},
+		/* 211 Action172 <- <{p.SetOption("src", text)}> */
+		func() bool {
+			{
+				add(ruleAction172, position)
+			}
+			return true
+		},
+		/* 212 Action173 <- <{p.SetOption("dst", text)}> */
+		func() bool {
+			{
+				add(ruleAction173, position)
+			}
+			return true
+		},
+		/* 213 Action174 <- <{p.SetOption("proto", text)}> */
+		func() bool {
+			{
+				add(ruleAction174, position)
+			}
+			return true
+		},
+		/* 214 Action175 <- <{p.SetOption("key", text)}> */
+		func() bool {
+			{
+				add(ruleAction175, position)
+			}
+			return true
+		},
+		/* 215 Action176 <- <{p.SetOption("name", text)}> */
+		func() bool {
+			{
+				add(ruleAction176, position)
+			}
+			return true
+		},
+		/* 216 Action177 <- <{p.IsKeepaddr = true}> */
+		func() bool {
+			{
+				add(ruleAction177, position)
+			}
+			return true
+		},
+		/* 217 Action178 <- <{p.IsKeepstate = true}> */
+		func() bool {
+			{
+				add(ruleAction178, position)
+			}
+			return true
+		},
+		/* 218 Action179 <- <{p.IsProxy = true; p.SetOption("neighbor", text)}> */
+		func() bool {
+			{
+				add(ruleAction179, position)
+			}
+			return true
+		},
+		/* 219 Action180 <- <{p.SetOption("neighbor", text)}> */
+		func() bool {
+			{
+				add(ruleAction180, position)
+			}
+			return true
+		},
+		/* 220 Action181 <- <{p.SetOption("lladdr", text)}> */
+		func() bool {
+			{
+				add(ruleAction181, position)
+			}
+			return true
+		},
+		/* 221 Action182 <- <{p.SetOption("dev", text)}> */
+		func() bool {
+			{
+				add(ruleAction182, position)
+			}
+			return true
+		},
+		/* 222 Action183 <- <{p.SetOption("nud", text)}> */
+		func() bool {
+			{
+				add(ruleAction183, position)
+			}
+			return true
+		},
+		/* 223 Action184 <- <{p.IsProxy = true}> */
+		func() bool {
+			{
+				add(ruleAction184, position)
+			}
+			return true
+		},
+		/* 224 Action185 <- <{p.SetOption("from", text)}> */
+		func() bool {
+			{
+				add(ruleAction185, position)
+			}
+			return true
+		},
+		/* 225 Action186 <- <{p.SetOption("iif", text)}> */
+		func() bool {
+			{
+				add(ruleAction186, position)
+			}
+			return true
+		},
+		/* 226 Action187 <- <{p.SetOption("fwmark", text)}> */
+		func() bool {
+			{
+				add(ruleAction187, position)
+			}
+			return true
+		},
+		/* 227 Action188 <- <{p.SetOption("family", text)}> */
+		func() bool {
+			{
+				add(ruleAction188, position)
+			}
+			return true
+		},
+		/* 228 Action189 <- <{p.SetOption("family", text)}> */
+		func() bool {
+			{
+				add(ruleAction189, position)
+			}
+			return true
+		},
+		/* 229 Action190 <- <{p.IsNot = true}> */
+		func() bool {
+			{
+				add(ruleAction190, position)
+			}
+			return true
+		},
+		/* 230 Action191 <- <{p.SetOption("from", text)}> */
+		func() bool {
+			{
+				add(ruleAction191, position)
+			}
+			return true
+		},
+		/* 231 Action192 <- <{p.SetOption("to", text)}> */
+		func() bool {
+			{
+				add(ruleAction192, position)
+			}
+			return true
+		},
+		/* 232 Action193 <- <{p.SetOption("iif", text)}> */
+		func() bool {
+			{
+				add(ruleAction193, position)
+			}
+			return true
+		},
+		/* 233 Action194 <- <{p.SetOption("oif", text)}> */
+		func() bool {
+			{
+				add(ruleAction194, position)
+			}
+			return true
+		},
+		/* 234 Action195 <- <{p.SetOption("fwmark", text)}> */
+		func() bool {
+			{
+				add(ruleAction195, position)
+			}
+			return true
+		},
+		/* 235 Action196 <- <{p.SetOption("table", text)}> */
+		func() bool {
+			{
+				add(ruleAction196, position)
+			}
+			return true
+		},
+		/* 236 Action197 <- <{p.SetOption("priority", text)}> */
+		func() bool {
+			{
+				add(ruleAction197, position)
 			}
 			return true
 		},
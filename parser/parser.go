@@ -9,8 +9,49 @@ import (
 const (
 	ROUTEADD = iota
 	ROUTEDEL
+	ROUTEREPLACE
+	ROUTECHANGE
+	ROUTEFLUSH
 	ADDRADD
 	ADDRDEL
+	ADDRFLUSH
+	RULEADD
+	RULEDEL
+	RULESHOW
+	LINKADD
+	LINKSET
+	VETHADD
+	LINKSHOW
+	ROUTESHOW
+	VRFSHOW
+	NEIGHADD
+	NEIGHDEL
+	NEIGHREPLACE
+	NEIGHSHOW
+	NEIGHFLUSH
+	LINKADOPT
+	LINKRELEASE
+	SYSCTLGET
+	SYSCTLSET
+	QDISCADD
+	QDISCREPLACE
+	QDISCDEL
+	QDISCSHOW
+	CLASSADD
+	CLASSREPLACE
+	CLASSDEL
+	CLASSSHOW
+	NATMASQUERADE
+	NATSNAT
+	NATDNAT
+	NATSHOW
+	NATFLUSH
+	FILTERADD
+	FILTERSHOW
+	FILTERFLUSH
+	CONNTRACKSHOW
+	CONNTRACKFLUSH
+	ROUTEGET
 	VIA
 	DEV
 )
@@ -23,16 +64,103 @@ const (
 	NSNONE
 )
 
+type VethEnd struct {
+    Name        string
+    TargetType  int
+    Target      string
+    Address     string
+}
+
 type Command struct {
     Operation   int
     TargetType  int
     Target      string
     IsError     bool
+    IgnoreExisting	bool
+    IgnoreMissing	bool
+    FlushConntrack	bool
     IsDefault	bool
     Network	string
     NetworkLength	string
     OptionVia   string
     OptionDev   string
+    IsOnlink    bool
+    IsViaInet6  bool
+    OptionTable string
+    OptionProto string
+    OptionScope string
+    IsNot       bool
+    OptionFrom  string
+    OptionTo    string
+    OptionIif   string
+    OptionOif   string
+    OptionFwmark	string
+    OptionPriority	string
+    OptionPeer  string
+    OptionBroadcast	string
+    OptionLabel string
+    OptionValidLft	string
+    OptionPreferredLft	string
+    IsNodad     bool
+    IsNoprefixroute	bool
+    IsHome      bool
+    IsMngtmpaddr	bool
+    OptionState string
+    OptionMtu   string
+    OptionLladdr	string
+    OptionName  string
+    OptionTxqueuelen	string
+    OptionAlias string
+    Veth        [2]VethEnd
+    OptionType  string
+    OptionParent	string
+    OptionID    string
+    OptionMode  string
+    OptionLocal string
+    OptionRemote	string
+    OptionDstport	string
+    OptionStp   string
+    OptionVlanFiltering	string
+    OptionMiimon	string
+    OptionMaster	string
+    IsNomaster  bool
+    OptionVrf   string
+    OptionNeighbor	string
+    OptionNud   string
+    IsProxy     bool
+    IsKeepaddr  bool
+    IsKeepstate bool
+    OptionKey   string
+    OptionValue string
+    OptionHandle	string
+    OptionClassid	string
+    OptionDelay string
+    OptionJitter	string
+    OptionLoss  string
+    OptionDuplicate	string
+    OptionRate  string
+    OptionCeil  string
+    OptionBurst string
+    OptionLatency	string
+    OptionDefault	string
+    OptionSrc   string
+    OptionDst   string
+    OptionDport string
+    OptionChain string
+    OptionVerdict	string
+    OptionMpls  string
+    OptionAs    string
+    OptionEncap string
+    OptionLabels	string
+    OptionSeg6Mode	string
+    OptionSegs  string
+    OptionAction	string
+    OptionNh6   string
+    OptionLocalTable	string
+    OptionVrfTable	string
+    OptionPref  string
+    OptionExpires	string
+    OptionFamily	string
 }
 
 func (c *Command) GetCommand() (*Command) {
@@ -41,6 +169,9 @@ func (c *Command) GetCommand() (*Command) {
 
 func (c *Command) Dump() {
     fmt.Printf("IsError:%v\n", c.IsError)
+    fmt.Printf("IgnoreExisting:%v\n", c.IgnoreExisting)
+    fmt.Printf("IgnoreMissing:%v\n", c.IgnoreMissing)
+    fmt.Printf("FlushConntrack:%v\n", c.FlushConntrack)
     fmt.Printf("TargetType:%d\n", c.TargetType)
     fmt.Printf("Target:%s\n", c.Target)
     fmt.Printf("Operation:%d\n", c.Operation)
@@ -48,6 +179,83 @@ func (c *Command) Dump() {
     fmt.Printf("NetworkLength:%s\n", c.NetworkLength)
     fmt.Printf("Via:%s\n", c.OptionVia)
     fmt.Printf("Dev:%s\n", c.OptionDev)
+    fmt.Printf("Onlink:%v\n", c.IsOnlink)
+    fmt.Printf("ViaInet6:%v\n", c.IsViaInet6)
+    fmt.Printf("Table:%s\n", c.OptionTable)
+    fmt.Printf("Proto:%s\n", c.OptionProto)
+    fmt.Printf("Scope:%s\n", c.OptionScope)
+    fmt.Printf("Not:%v\n", c.IsNot)
+    fmt.Printf("From:%s\n", c.OptionFrom)
+    fmt.Printf("To:%s\n", c.OptionTo)
+    fmt.Printf("Iif:%s\n", c.OptionIif)
+    fmt.Printf("Oif:%s\n", c.OptionOif)
+    fmt.Printf("Fwmark:%s\n", c.OptionFwmark)
+    fmt.Printf("Priority:%s\n", c.OptionPriority)
+    fmt.Printf("Peer:%s\n", c.OptionPeer)
+    fmt.Printf("Broadcast:%s\n", c.OptionBroadcast)
+    fmt.Printf("Label:%s\n", c.OptionLabel)
+    fmt.Printf("ValidLft:%s\n", c.OptionValidLft)
+    fmt.Printf("PreferredLft:%s\n", c.OptionPreferredLft)
+    fmt.Printf("Nodad:%v\n", c.IsNodad)
+    fmt.Printf("Noprefixroute:%v\n", c.IsNoprefixroute)
+    fmt.Printf("Home:%v\n", c.IsHome)
+    fmt.Printf("Mngtmpaddr:%v\n", c.IsMngtmpaddr)
+    fmt.Printf("State:%s\n", c.OptionState)
+    fmt.Printf("Mtu:%s\n", c.OptionMtu)
+    fmt.Printf("Lladdr:%s\n", c.OptionLladdr)
+    fmt.Printf("Name:%s\n", c.OptionName)
+    fmt.Printf("Txqueuelen:%s\n", c.OptionTxqueuelen)
+    fmt.Printf("Alias:%s\n", c.OptionAlias)
+    fmt.Printf("Veth:%v\n", c.Veth)
+    fmt.Printf("Type:%s\n", c.OptionType)
+    fmt.Printf("Parent:%s\n", c.OptionParent)
+    fmt.Printf("ID:%s\n", c.OptionID)
+    fmt.Printf("Mode:%s\n", c.OptionMode)
+    fmt.Printf("Local:%s\n", c.OptionLocal)
+    fmt.Printf("Remote:%s\n", c.OptionRemote)
+    fmt.Printf("Dstport:%s\n", c.OptionDstport)
+    fmt.Printf("Stp:%s\n", c.OptionStp)
+    fmt.Printf("VlanFiltering:%s\n", c.OptionVlanFiltering)
+    fmt.Printf("Miimon:%s\n", c.OptionMiimon)
+    fmt.Printf("Master:%s\n", c.OptionMaster)
+    fmt.Printf("Nomaster:%v\n", c.IsNomaster)
+    fmt.Printf("Vrf:%s\n", c.OptionVrf)
+    fmt.Printf("Neighbor:%s\n", c.OptionNeighbor)
+    fmt.Printf("Nud:%s\n", c.OptionNud)
+    fmt.Printf("Proxy:%v\n", c.IsProxy)
+    fmt.Printf("Keepaddr:%v\n", c.IsKeepaddr)
+    fmt.Printf("Keepstate:%v\n", c.IsKeepstate)
+    fmt.Printf("Key:%s\n", c.OptionKey)
+    fmt.Printf("Value:%s\n", c.OptionValue)
+    fmt.Printf("Handle:%s\n", c.OptionHandle)
+    fmt.Printf("Classid:%s\n", c.OptionClassid)
+    fmt.Printf("Delay:%s\n", c.OptionDelay)
+    fmt.Printf("Jitter:%s\n", c.OptionJitter)
+    fmt.Printf("Loss:%s\n", c.OptionLoss)
+    fmt.Printf("Duplicate:%s\n", c.OptionDuplicate)
+    fmt.Printf("Rate:%s\n", c.OptionRate)
+    fmt.Printf("Ceil:%s\n", c.OptionCeil)
+    fmt.Printf("Burst:%s\n", c.OptionBurst)
+    fmt.Printf("Latency:%s\n", c.OptionLatency)
+    fmt.Printf("Default:%s\n", c.OptionDefault)
+    fmt.Printf("Src:%s\n", c.OptionSrc)
+    fmt.Printf("Dst:%s\n", c.OptionDst)
+    fmt.Printf("Dport:%s\n", c.OptionDport)
+    fmt.Printf("Chain:%s\n", c.OptionChain)
+    fmt.Printf("Verdict:%s\n", c.OptionVerdict)
+    fmt.Printf("Mpls:%s\n", c.OptionMpls)
+    fmt.Printf("As:%s\n", c.OptionAs)
+    fmt.Printf("Encap:%s\n", c.OptionEncap)
+    fmt.Printf("Labels:%s\n", c.OptionLabels)
+    fmt.Printf("Seg6Mode:%s\n", c.OptionSeg6Mode)
+    fmt.Printf("Segs:%s\n", c.OptionSegs)
+    fmt.Printf("Action:%s\n", c.OptionAction)
+    fmt.Printf("Nh6:%s\n", c.OptionNh6)
+    fmt.Printf("LocalTable:%s\n", c.OptionLocalTable)
+    fmt.Printf("VrfTable:%s\n", c.OptionVrfTable)
+    fmt.Printf("Pref:%s\n", c.OptionPref)
+    fmt.Printf("Expires:%s\n", c.OptionExpires)
+    fmt.Printf("Family:%s\n", c.OptionFamily)
 }
 
 func (c *Command) SetOption(name string, val string) {
@@ -56,9 +264,147 @@ func (c *Command) SetOption(name string, val string) {
 		c.OptionVia = val
 	case "dev":
 		c.OptionDev = val
+	case "table":
+		c.OptionTable = val
+	case "proto":
+		c.OptionProto = val
+	case "scope":
+		c.OptionScope = val
+	case "from":
+		c.OptionFrom = val
+	case "to":
+		c.OptionTo = val
+	case "iif":
+		c.OptionIif = val
+	case "oif":
+		c.OptionOif = val
+	case "fwmark":
+		c.OptionFwmark = val
+	case "priority":
+		c.OptionPriority = val
+	case "peer":
+		c.OptionPeer = val
+	case "broadcast":
+		c.OptionBroadcast = val
+	case "label":
+		c.OptionLabel = val
+	case "valid_lft":
+		c.OptionValidLft = val
+	case "preferred_lft":
+		c.OptionPreferredLft = val
+	case "state":
+		c.OptionState = val
+	case "mtu":
+		c.OptionMtu = val
+	case "lladdr":
+		c.OptionLladdr = val
+	case "name":
+		c.OptionName = val
+	case "txqueuelen":
+		c.OptionTxqueuelen = val
+	case "alias":
+		c.OptionAlias = val
+	case "type":
+		c.OptionType = val
+	case "parent":
+		c.OptionParent = val
+	case "id":
+		c.OptionID = val
+	case "mode":
+		c.OptionMode = val
+	case "local":
+		c.OptionLocal = val
+	case "remote":
+		c.OptionRemote = val
+	case "dstport":
+		c.OptionDstport = val
+	case "stp":
+		c.OptionStp = val
+	case "vlan_filtering":
+		c.OptionVlanFiltering = val
+	case "miimon":
+		c.OptionMiimon = val
+	case "master":
+		c.OptionMaster = val
+	case "vrf":
+		c.OptionVrf = val
+	case "neighbor":
+		c.OptionNeighbor = val
+	case "nud":
+		c.OptionNud = val
+	case "key":
+		c.OptionKey = val
+	case "value":
+		c.OptionValue = val
+	case "handle":
+		c.OptionHandle = val
+	case "classid":
+		c.OptionClassid = val
+	case "delay":
+		c.OptionDelay = val
+	case "jitter":
+		c.OptionJitter = val
+	case "loss":
+		c.OptionLoss = val
+	case "duplicate":
+		c.OptionDuplicate = val
+	case "rate":
+		c.OptionRate = val
+	case "ceil":
+		c.OptionCeil = val
+	case "burst":
+		c.OptionBurst = val
+	case "latency":
+		c.OptionLatency = val
+	case "default":
+		c.OptionDefault = val
+	case "src":
+		c.OptionSrc = val
+	case "dst":
+		c.OptionDst = val
+	case "dport":
+		c.OptionDport = val
+	case "chain":
+		c.OptionChain = val
+	case "verdict":
+		c.OptionVerdict = val
+	case "mpls":
+		c.OptionMpls = val
+	case "as":
+		c.OptionAs = val
+	case "encap":
+		c.OptionEncap = val
+	case "labels":
+		c.OptionLabels = val
+	case "seg6mode":
+		c.OptionSeg6Mode = val
+	case "segs":
+		c.OptionSegs = val
+	case "action":
+		c.OptionAction = val
+	case "nh6":
+		c.OptionNh6 = val
+	case "localtable":
+		c.OptionLocalTable = val
+	case "vrftable":
+		c.OptionVrfTable = val
+	case "pref":
+		c.OptionPref = val
+	case "expires":
+		c.OptionExpires = val
+	case "family":
+		c.OptionFamily = val
 	}
 }
 
+// SetVethNS moves the namespace just parsed into given end of veth
+func (c *Command) SetVethNS(end int) {
+	c.Veth[end].TargetType = c.TargetType
+	c.Veth[end].Target = c.Target
+	c.TargetType = NSNONE
+	c.Target = ""
+}
+
 func (c *Command) Err(pos int, buffer string, message string) {
     fmt.Println("")
     a := strings.Split(buffer[:pos], "\n")
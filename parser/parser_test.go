@@ -24,4 +24,486 @@ func TestParseCommand (t *testing.T) {
 		   p.Dump()
 		   t.Fatalf("failed at parsing: %s", test2)
 	   }
+	test3 := "pid 1234 rule add not from 10.1.1.0/24 iif eth1 fwmark 0x10/0xff table 100 priority 1000"
+	if p := ParseCommand(test3);
+	   p.TargetType != PID ||
+	   p.Target != "1234" ||
+	   p.Operation != RULEADD ||
+	   p.IsNot != true ||
+	   p.OptionFrom != "10.1.1.0/24" ||
+	   p.OptionIif != "eth1" ||
+	   p.OptionFwmark != "0x10/0xff" ||
+	   p.OptionTable != "100" ||
+	   p.OptionPriority != "1000" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test3)
+	   }
+	test4 := "docker testDocker route add 10.1.2.0/24 via 10.1.1.1 table 100"
+	if p := ParseCommand(test4);
+	   p.Operation != ROUTEADD ||
+	   p.OptionVia != "10.1.1.1" ||
+	   p.OptionTable != "100" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test4)
+	   }
+	test5 := "docker testDocker route replace default via 10.1.1.254"
+	if p := ParseCommand(test5);
+	   p.Operation != ROUTEREPLACE ||
+	   p.IsDefault != true ||
+	   p.OptionVia != "10.1.1.254" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test5)
+	   }
+	test6 := "--ignore-existing --ignore-missing docker testDocker address add 10.1.1.2/24 dev eth0"
+	if p := ParseCommand(test6);
+	   p.IgnoreExisting != true ||
+	   p.IgnoreMissing != true ||
+	   p.TargetType != DOCKER ||
+	   p.Operation != ADDRADD ||
+	   p.OptionDev != "eth0" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test6)
+	   }
+	test7 := "ipnetns testNS route flush table mgmt dev eth1 proto static"
+	if p := ParseCommand(test7);
+	   p.TargetType != IPNETNS ||
+	   p.Operation != ROUTEFLUSH ||
+	   p.OptionTable != "mgmt" ||
+	   p.OptionDev != "eth1" ||
+	   p.OptionProto != "static" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test7)
+	   }
+	test8 := "ipnetns testNS address flush dev eth1 scope global"
+	if p := ParseCommand(test8);
+	   p.Operation != ADDRFLUSH ||
+	   p.OptionDev != "eth1" ||
+	   p.OptionScope != "global" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test8)
+	   }
+	test9 := "docker testDocker address add 2001:db8::1/64 dev eth0 preferred_lft 0 nodad noprefixroute"
+	if p := ParseCommand(test9);
+	   p.Operation != ADDRADD ||
+	   p.Network != "2001:db8::1" ||
+	   p.OptionDev != "eth0" ||
+	   p.OptionPreferredLft != "0" ||
+	   p.IsNodad != true ||
+	   p.IsNoprefixroute != true {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test9)
+	   }
+	test10 := "docker testDocker link set eth1 down name data0 mtu 9000 address 02:00:00:00:00:01 up"
+	if p := ParseCommand(test10);
+	   p.Operation != LINKSET ||
+	   p.OptionDev != "eth1" ||
+	   p.OptionName != "data0" ||
+	   p.OptionMtu != "9000" ||
+	   p.OptionLladdr != "02:00:00:00:00:01" ||
+	   p.OptionState != "up" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test10)
+	   }
+	test11 := "link add veth eth1 docker testDocker1 peer eth2 pid 1234 address 10.1.1.1/24 10.1.1.2/24"
+	if p := ParseCommand(test11);
+	   p.Operation != VETHADD ||
+	   p.TargetType != NSNONE ||
+	   p.Veth[0] != (VethEnd{"eth1", DOCKER, "testDocker1", "10.1.1.1/24"}) ||
+	   p.Veth[1] != (VethEnd{"eth2", PID, "1234", "10.1.1.2/24"}) {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test11)
+	   }
+	test12 := "docker testDocker link add macvlan mv0 link eth0 mode bridge name eth1 up"
+	if p := ParseCommand(test12);
+	   p.Operation != LINKADD ||
+	   p.OptionType != "macvlan" ||
+	   p.OptionDev != "mv0" ||
+	   p.OptionParent != "eth0" ||
+	   p.OptionMode != "bridge" ||
+	   p.OptionName != "eth1" ||
+	   p.OptionState != "up" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test12)
+	   }
+	test13 := "docker testDocker link add vxlan vx0 id 100 local 192.168.1.1 remote 192.168.1.2 dstport 4789 dev eth0 up"
+	if p := ParseCommand(test13);
+	   p.Operation != LINKADD ||
+	   p.OptionType != "vxlan" ||
+	   p.OptionDev != "vx0" ||
+	   p.OptionID != "100" ||
+	   p.OptionLocal != "192.168.1.1" ||
+	   p.OptionRemote != "192.168.1.2" ||
+	   p.OptionDstport != "4789" ||
+	   p.OptionParent != "eth0" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test13)
+	   }
+
+	test14 := "netns /var/run/netns/test link add bond bond0 mode active-backup miimon 100 up"
+	if p := ParseCommand(test14);
+	   p.Operation != LINKADD ||
+	   p.OptionType != "bond" ||
+	   p.OptionDev != "bond0" ||
+	   p.OptionMode != "active-backup" ||
+	   p.OptionMiimon != "100" ||
+	   p.OptionState != "up" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test14)
+	   }
+
+	test15 := "pid 1234 link set eth1 master br0"
+	if p := ParseCommand(test15);
+	   p.Operation != LINKSET ||
+	   p.OptionDev != "eth1" ||
+	   p.OptionMaster != "br0" ||
+	   p.IsNomaster {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test15)
+	   }
+
+	test16 := "docker testDocker route add 10.1.1.0/24 via 10.1.1.1 vrf mgmt"
+	if p := ParseCommand(test16);
+	   p.Operation != ROUTEADD ||
+	   p.OptionVia != "10.1.1.1" ||
+	   p.OptionVrf != "mgmt" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test16)
+	   }
+
+	test17 := "docker testDocker link add vrf mgmt table 10 up"
+	if p := ParseCommand(test17);
+	   p.Operation != LINKADD ||
+	   p.OptionType != "vrf" ||
+	   p.OptionDev != "mgmt" ||
+	   p.OptionTable != "10" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test17)
+	   }
+
+	test18 := "docker testDocker vrf show"
+	if p := ParseCommand(test18); p.Operation != VRFSHOW {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test18)
+	   }
+
+	test19 := "docker testDocker neighbor add 10.1.1.1 lladdr 02:00:00:00:00:01 dev eth0 nud noarp"
+	if p := ParseCommand(test19);
+	   p.Operation != NEIGHADD ||
+	   p.OptionNeighbor != "10.1.1.1" ||
+	   p.OptionLladdr != "02:00:00:00:00:01" ||
+	   p.OptionDev != "eth0" ||
+	   p.OptionNud != "noarp" ||
+	   p.IsProxy {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test19)
+	   }
+
+	test20 := "docker testDocker neighbor del proxy 2001:db8::1 dev eth0"
+	if p := ParseCommand(test20);
+	   p.Operation != NEIGHDEL ||
+	   p.OptionNeighbor != "2001:db8::1" ||
+	   p.OptionDev != "eth0" ||
+	   !p.IsProxy {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test20)
+	   }
+
+	test21 := "docker testDocker link adopt ens1f0v0 name net1 keepaddr keepstate"
+	if p := ParseCommand(test21);
+	   p.Operation != LINKADOPT ||
+	   p.OptionDev != "ens1f0v0" ||
+	   p.OptionName != "net1" ||
+	   !p.IsKeepaddr ||
+	   !p.IsKeepstate {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test21)
+	   }
+
+	test22 := "docker testDocker link release net1"
+	if p := ParseCommand(test22);
+	   p.Operation != LINKRELEASE ||
+	   p.OptionDev != "net1" ||
+	   p.IsKeepaddr {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test22)
+	   }
+
+	test23 := "ipnetns test sysctl set net.ipv4.conf.eth0/100.rp_filter 2"
+	if p := ParseCommand(test23);
+	   p.Operation != SYSCTLSET ||
+	   p.OptionKey != "net.ipv4.conf.eth0/100.rp_filter" ||
+	   p.OptionValue != "2" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test23)
+	   }
+
+	test24 := "docker testDocker qdisc add dev eth0 root netem delay 100ms jitter 10ms loss 1% duplicate 0.5"
+	if p := ParseCommand(test24);
+	   p.Operation != QDISCADD ||
+	   p.OptionDev != "eth0" ||
+	   p.OptionParent != "root" ||
+	   p.OptionType != "netem" ||
+	   p.OptionDelay != "100ms" ||
+	   p.OptionJitter != "10ms" ||
+	   p.OptionLoss != "1%" ||
+	   p.OptionDuplicate != "0.5" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test24)
+	   }
+
+	test25 := "docker testDocker class add dev eth0 parent 1: classid 1:10 htb rate 10mbit ceil 20mbit"
+	if p := ParseCommand(test25);
+	   p.Operation != CLASSADD ||
+	   p.OptionParent != "1:" ||
+	   p.OptionClassid != "1:10" ||
+	   p.OptionType != "htb" ||
+	   p.OptionRate != "10mbit" ||
+	   p.OptionCeil != "20mbit" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test25)
+	   }
+
+	test26 := "docker testDocker nat dnat dst 10.0.0.1 iif eth0 proto tcp dport 80 to 10.1.1.2:8080"
+	if p := ParseCommand(test26);
+	   p.Operation != NATDNAT ||
+	   p.OptionDst != "10.0.0.1" ||
+	   p.OptionIif != "eth0" ||
+	   p.OptionProto != "tcp" ||
+	   p.OptionDport != "80" ||
+	   p.OptionTo != "10.1.1.2:8080" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test26)
+	   }
+
+	test27 := "docker testDocker filter forward drop src 10.1.1.0/24 oif eth1"
+	if p := ParseCommand(test27);
+	   p.Operation != FILTERADD ||
+	   p.OptionChain != "forward" ||
+	   p.OptionVerdict != "drop" ||
+	   p.OptionSrc != "10.1.1.0/24" ||
+	   p.OptionOif != "eth1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test27)
+	   }
+
+	test28 := "--flush-conntrack docker testDocker route replace 10.1.1.0/24 via 10.0.0.1"
+	if p := ParseCommand(test28);
+	   p.Operation != ROUTEREPLACE ||
+	   !p.FlushConntrack ||
+	   p.OptionVia != "10.0.0.1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test28)
+	   }
+
+	test29 := "docker testDocker conntrack flush src 10.1.1.0/24 proto udp"
+	if p := ParseCommand(test29);
+	   p.Operation != CONNTRACKFLUSH ||
+	   p.OptionSrc != "10.1.1.0/24" ||
+	   p.OptionProto != "udp" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test29)
+	   }
+
+	test30 := "docker testDocker route add mpls 100 via 10.0.0.1 dev eth0 as 200/300"
+	if p := ParseCommand(test30);
+	   p.Operation != ROUTEADD ||
+	   p.OptionMpls != "100" ||
+	   p.OptionVia != "10.0.0.1" ||
+	   p.OptionAs != "200/300" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test30)
+	   }
+
+	test31 := "docker testDocker route add 10.1.1.0/24 encap mpls 100/200 via 10.0.0.1"
+	if p := ParseCommand(test31);
+	   p.Operation != ROUTEADD ||
+	   p.Network != "10.1.1.0" ||
+	   p.OptionEncap != "mpls" ||
+	   p.OptionLabels != "100/200" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test31)
+	   }
+
+	test32 := "docker testDocker route add 10.1.1.0/24 encap seg6 mode encap segs fc00::1,fc00::2 dev eth0"
+	if p := ParseCommand(test32);
+	   p.Operation != ROUTEADD ||
+	   p.OptionEncap != "seg6" ||
+	   p.OptionSeg6Mode != "encap" ||
+	   p.OptionSegs != "fc00::1,fc00::2" ||
+	   p.OptionDev != "eth0" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test32)
+	   }
+
+	test33 := "docker testDocker route add fc00::100/128 encap seg6local action End.DT6 vrftable blue dev eth0"
+	if p := ParseCommand(test33);
+	   p.Operation != ROUTEADD ||
+	   p.OptionEncap != "seg6local" ||
+	   p.OptionAction != "End.DT6" ||
+	   p.OptionVrfTable != "blue" ||
+	   p.OptionDev != "eth0" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test33)
+	   }
+
+	test34 := "docker testDocker route get 8.8.8.8 from 10.1.1.2 iif eth0 mark 0x10"
+	if p := ParseCommand(test34);
+	   p.Operation != ROUTEGET ||
+	   p.OptionTo != "8.8.8.8" ||
+	   p.OptionFrom != "10.1.1.2" ||
+	   p.OptionIif != "eth0" ||
+	   p.OptionFwmark != "0x10" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test34)
+	   }
+
+	test35 := "docker testDocker route add 10.1.2.0/24 via 192.168.1.1 dev eth0 onlink"
+	if p := ParseCommand(test35);
+	   p.Operation != ROUTEADD ||
+	   p.OptionVia != "192.168.1.1" ||
+	   p.OptionDev != "eth0" ||
+	   !p.IsOnlink {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test35)
+	   }
+
+	test36 := "docker testDocker route add 10.1.1.5 via 10.0.0.1"
+	if p := ParseCommand(test36);
+	   p.Operation != ROUTEADD ||
+	   p.IsDefault ||
+	   p.Network != "10.1.1.5" ||
+	   p.NetworkLength != "" ||
+	   p.OptionVia != "10.0.0.1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test36)
+	   }
+
+	test37 := "docker testDocker route add default via fc00::1"
+	if p := ParseCommand(test37);
+	   p.Operation != ROUTEADD ||
+	   !p.IsDefault ||
+	   p.Network != "" ||
+	   p.OptionVia != "fc00::1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test37)
+	   }
+
+	test38 := "docker testDocker route add 2001:db8::/32 via fe80::1 dev eth0 pref high expires 300"
+	if p := ParseCommand(test38);
+	   p.Operation != ROUTEADD ||
+	   p.Network != "2001:db8::" ||
+	   p.NetworkLength != "32" ||
+	   p.OptionVia != "fe80::1" ||
+	   p.OptionPref != "high" ||
+	   p.OptionExpires != "300" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test38)
+	   }
+
+	test39 := "docker testDocker route add 10.1.1.0/24 via inet6 fc00::1 dev eth0"
+	if p := ParseCommand(test39);
+	   p.Operation != ROUTEADD ||
+	   !p.IsViaInet6 ||
+	   p.OptionVia != "fc00::1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test39)
+	   }
+
+	test40 := "docker testDocker route add ::ffff:10.1.1.0/120 dev eth0"
+	if p := ParseCommand(test40);
+	   p.Operation != ROUTEADD ||
+	   p.Network != "::ffff:10.1.1.0" ||
+	   p.NetworkLength != "120" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test40)
+	   }
+
+	test41 := "docker testDocker route add :: dev eth0"
+	if p := ParseCommand(test41);
+	   p.Operation != ROUTEADD ||
+	   p.Network != "::" ||
+	   p.NetworkLength != "" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test41)
+	   }
+
+	test42 := "docker testDocker route add fd00:0:0:1::ab/64 via fd00::1"
+	if p := ParseCommand(test42);
+	   p.Operation != ROUTEADD ||
+	   p.Network != "fd00:0:0:1::ab" ||
+	   p.NetworkLength != "64" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test42)
+	   }
+
+	test43 := "docker testDocker route add 10.1.5.0/24 via docker:router@eth1"
+	if p := ParseCommand(test43);
+	   p.Operation != ROUTEADD ||
+	   p.OptionVia != "docker:router@eth1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test43)
+	   }
+
+	test45 := "ipnetns test sysctl set net.ipv4.ip_local_port_range 32768 60999"
+	if p := ParseCommand(test45);
+	   p.Operation != SYSCTLSET ||
+	   p.OptionKey != "net.ipv4.ip_local_port_range" ||
+	   p.OptionValue != "32768 60999" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test45)
+	   }
+
+	test46 := "docker a link add veth eth1 docker testDocker1 peer eth2 pid 1234"
+	if p := ParseCommand(test46); !p.IsError {
+		p.Dump()
+		t.Fatalf("should fail at parsing: %s", test46)
+	}
+
+	test47 := "docker testDocker rule add inet6 fwmark 0x10 table 100"
+	if p := ParseCommand(test47);
+	   p.Operation != RULEADD ||
+	   p.OptionFamily != "inet6" ||
+	   p.OptionFwmark != "0x10" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test47)
+	   }
+
+	test48 := "docker testDocker rule show inet"
+	if p := ParseCommand(test48);
+	   p.Operation != RULESHOW ||
+	   p.OptionFamily != "inet" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test48)
+	   }
+
+	test49 := "docker testDocker route add default via inet6 docker:router@eth1"
+	if p := ParseCommand(test49);
+	   p.Operation != ROUTEADD ||
+	   !p.IsDefault ||
+	   !p.IsViaInet6 ||
+	   p.OptionVia != "docker:router@eth1" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test49)
+	   }
+
+	test50 := "docker testDocker route flush inet6 dev eth0"
+	if p := ParseCommand(test50);
+	   p.Operation != ROUTEFLUSH ||
+	   p.OptionFamily != "inet6" ||
+	   p.OptionDev != "eth0" {
+		   p.Dump()
+		   t.Fatalf("failed at parsing: %s", test50)
+	   }
+
+	for _, test44 := range []string{
+		"docker testDocker address add 10.1.1.1 dev eth0",
+		"docker testDocker address add default dev eth0",
+		"docker testDocker address add mpls 100 dev eth0",
+		"docker testDocker address del 2001:db8::1 dev eth0",
+	} {
+		if p := ParseCommand(test44); !p.IsError {
+			p.Dump()
+			t.Fatalf("should fail at parsing: %s", test44)
+		}
+	}
 }
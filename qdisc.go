@@ -0,0 +1,473 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+)
+
+// qdiscOptions are options which each qdisc/class type supports
+var qdiscOptions = map[string][]string{
+	"netem": {"delay", "jitter", "loss", "duplicate"},
+	"tbf":   {"rate", "burst", "latency"},
+	"htb":   {"default"},
+	"class": {"rate", "ceil"},
+}
+
+// checkQdiscOptions checks whether the qdisc type (or htb class) supports
+// given options
+func checkQdiscOptions(command *parser.Command, kind string) error {
+	supported := qdiscOptions[kind]
+	given := map[string]string{
+		"delay":     command.OptionDelay,
+		"jitter":    command.OptionJitter,
+		"loss":      command.OptionLoss,
+		"duplicate": command.OptionDuplicate,
+		"rate":      command.OptionRate,
+		"ceil":      command.OptionCeil,
+		"burst":     command.OptionBurst,
+		"latency":   command.OptionLatency,
+		"default":   command.OptionDefault,
+	}
+	for _, name := range []string{"delay", "jitter", "loss", "duplicate",
+		"rate", "ceil", "burst", "latency", "default"} {
+		if given[name] == "" {
+			continue
+		}
+		found := false
+		for _, option := range supported {
+			found = found || option == name
+		}
+		if !found {
+			return fmt.Errorf("%s does not support %s", kind, name)
+		}
+	}
+	return nil
+}
+
+// parseTcHandle parses handle as tc does, "root", "MAJOR:" or "MAJOR:MINOR"
+// in hex
+func parseTcHandle(handle string) (uint32, error) {
+	if handle == "root" {
+		return netlink.HANDLE_ROOT, nil
+	}
+	i := strings.Index(handle, ":")
+	if i < 0 {
+		return 0, fmt.Errorf("invalid handle %q", handle)
+	}
+	major, err := strconv.ParseUint(handle[:i], 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid handle %q", handle)
+	}
+	minor := uint64(0)
+	if handle[i+1:] != "" {
+		if minor, err = strconv.ParseUint(handle[i+1:], 16, 16); err != nil {
+			return 0, fmt.Errorf("invalid handle %q", handle)
+		}
+	}
+	return netlink.MakeHandle(uint16(major), uint16(minor)), nil
+}
+
+// formatTcHandle formats handle as tc does, "MAJOR:" for the handle of
+// qdisc
+func formatTcHandle(handle uint32) string {
+	if major, minor := netlink.MajorMinor(handle); major != 0 && minor == 0 {
+		return fmt.Sprintf("%x:", major)
+	}
+	return netlink.HandleStr(handle)
+}
+
+// splitUnit splits number and its unit such as "100ms"
+func splitUnit(val string) (float64, string, error) {
+	i := strings.IndexFunc(val, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(val)
+	}
+	num, err := strconv.ParseFloat(val[:i], 64)
+	return num, strings.ToLower(val[i:]), err
+}
+
+// rateUnits are rate units of tc in bits per second
+var rateUnits = map[string]float64{
+	"":     1,
+	"bit":  1,
+	"kbit": 1e3,
+	"mbit": 1e6,
+	"gbit": 1e9,
+	"bps":  8,
+	"kbps": 8e3,
+	"mbps": 8e6,
+	"gbps": 8e9,
+}
+
+// parseRate parses rate such as "1mbit" into bits per second
+func parseRate(rate string) (uint64, error) {
+	num, unit, err := splitUnit(rate)
+	mul, ok := rateUnits[unit]
+	if err != nil || !ok || num <= 0 {
+		return 0, fmt.Errorf("invalid rate %q", rate)
+	}
+	return uint64(num * mul), nil
+}
+
+// formatRate formats rate in bits per second as tc does
+func formatRate(rate uint64) string {
+	for _, unit := range []struct {
+		name string
+		mul  uint64
+	}{{"Gbit", 1e9}, {"Mbit", 1e6}, {"Kbit", 1e3}} {
+		if rate >= unit.mul && rate%unit.mul == 0 {
+			return fmt.Sprintf("%d%s", rate/unit.mul, unit.name)
+		}
+	}
+	return fmt.Sprintf("%dbit", rate)
+}
+
+// sizeUnits are size units of tc in bytes
+var sizeUnits = map[string]float64{
+	"":   1,
+	"b":  1,
+	"k":  1024,
+	"kb": 1024,
+	"m":  1024 * 1024,
+	"mb": 1024 * 1024,
+}
+
+// parseSize parses size such as "32kb" into bytes
+func parseSize(size string) (uint32, error) {
+	num, unit, err := splitUnit(size)
+	mul, ok := sizeUnits[unit]
+	if err != nil || !ok || num <= 0 || num*mul > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid size %q", size)
+	}
+	return uint32(num * mul), nil
+}
+
+// parseTcTime parses time such as "100ms" into microseconds. A bare number
+// is microseconds as tc does.
+func parseTcTime(val string) (uint32, error) {
+	var usec float64
+	if num, unit, err := splitUnit(val); err == nil && unit == "" {
+		usec = num
+	} else {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %q", val)
+		}
+		usec = float64(d / time.Microsecond)
+	}
+	if usec < 0 || usec > math.MaxUint32 {
+		return 0, fmt.Errorf("invalid time %q", val)
+	}
+	return uint32(usec), nil
+}
+
+// parsePercent parses percentage such as "1%" or "0.5"
+func parsePercent(name, val string) (float32, error) {
+	num, err := strconv.ParseFloat(strings.TrimSuffix(val, "%"), 32)
+	if err != nil || num < 0 || num > 100 {
+		return 0, fmt.Errorf("invalid %s %q", name, val)
+	}
+	return float32(num), nil
+}
+
+// getQdiscAttrs converts parent/handle given in CLI into netlink.QdiscAttrs.
+// parent is root by default.
+func getQdiscAttrs(command *parser.Command, linkIndex int) (attrs netlink.QdiscAttrs, err error) {
+	attrs = netlink.QdiscAttrs{LinkIndex: linkIndex, Parent: netlink.HANDLE_ROOT}
+	if command.OptionParent != "" {
+		if attrs.Parent, err = parseTcHandle(command.OptionParent); err != nil {
+			return attrs, err
+		}
+	}
+	if command.OptionHandle != "" {
+		if attrs.Handle, err = parseTcHandle(command.OptionHandle); err != nil {
+			return attrs, err
+		}
+	}
+	return attrs, nil
+}
+
+// GetNetlinkQdisc converts from CLI argument to netlink.Qdisc
+func GetNetlinkQdisc(command *parser.Command, linkIndex int) (qdisc netlink.Qdisc, err error) {
+	attrs, err := getQdiscAttrs(command, linkIndex)
+	if err != nil {
+		return nil, err
+	}
+	if command.Operation == parser.QDISCDEL {
+		return &netlink.GenericQdisc{QdiscAttrs: attrs, QdiscType: command.OptionType}, nil
+	}
+	if command.OptionType == "" {
+		return nil, fmt.Errorf("qdisc requires type, netem, tbf or htb")
+	}
+	if err = checkQdiscOptions(command, command.OptionType); err != nil {
+		return nil, err
+	}
+
+	switch command.OptionType {
+	case "netem":
+		var nattrs netlink.NetemQdiscAttrs
+		if command.OptionDelay != "" {
+			if nattrs.Latency, err = parseTcTime(command.OptionDelay); err != nil {
+				return nil, err
+			}
+		}
+		if command.OptionJitter != "" {
+			if command.OptionDelay == "" {
+				return nil, fmt.Errorf("jitter requires delay")
+			}
+			if nattrs.Jitter, err = parseTcTime(command.OptionJitter); err != nil {
+				return nil, err
+			}
+		}
+		if command.OptionLoss != "" {
+			if nattrs.Loss, err = parsePercent("loss", command.OptionLoss); err != nil {
+				return nil, err
+			}
+		}
+		if command.OptionDuplicate != "" {
+			if nattrs.Duplicate, err = parsePercent("duplicate", command.OptionDuplicate); err != nil {
+				return nil, err
+			}
+		}
+		qdisc = netlink.NewNetem(attrs, nattrs)
+	case "tbf":
+		if command.OptionRate == "" || command.OptionBurst == "" || command.OptionLatency == "" {
+			return nil, fmt.Errorf("tbf requires rate, burst and latency")
+		}
+		rate, err := parseRate(command.OptionRate)
+		if err != nil {
+			return nil, err
+		}
+		burst, err := parseSize(command.OptionBurst)
+		if err != nil {
+			return nil, err
+		}
+		latency, err := parseTcTime(command.OptionLatency)
+		if err != nil {
+			return nil, err
+		}
+		// same as tc, limit is the bytes which can be queued for latency
+		rate /= 8
+		qdisc = &netlink.Tbf{
+			QdiscAttrs: attrs,
+			Rate:       rate,
+			Limit:      uint32(float64(rate)*float64(latency)/1e6) + burst,
+			Buffer:     netlink.Xmittime(rate, burst),
+		}
+	case "htb":
+		htb := netlink.NewHtb(attrs)
+		if command.OptionDefault != "" {
+			defcls, err := strconv.ParseUint(command.OptionDefault, 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid default %q", command.OptionDefault)
+			}
+			htb.Defcls = uint32(defcls)
+		}
+		qdisc = htb
+	}
+	return qdisc, nil
+}
+
+// GetNetlinkClass converts from CLI argument to netlink.Class. Only htb
+// class is supported.
+func GetNetlinkClass(command *parser.Command, linkIndex int) (class netlink.Class, err error) {
+	if command.OptionParent == "" || command.OptionClassid == "" {
+		return nil, fmt.Errorf("class requires parent and classid")
+	}
+	attrs := netlink.ClassAttrs{LinkIndex: linkIndex}
+	if attrs.Parent, err = parseTcHandle(command.OptionParent); err != nil {
+		return nil, err
+	}
+	if attrs.Handle, err = parseTcHandle(command.OptionClassid); err != nil {
+		return nil, err
+	}
+	if command.Operation == parser.CLASSDEL {
+		return &netlink.GenericClass{ClassAttrs: attrs, ClassType: "htb"}, nil
+	}
+
+	if command.OptionType != "htb" {
+		return nil, fmt.Errorf("class supports only htb")
+	}
+	if err = checkQdiscOptions(command, "class"); err != nil {
+		return nil, err
+	}
+	if command.OptionRate == "" {
+		return nil, fmt.Errorf("htb class requires rate")
+	}
+	var cattrs netlink.HtbClassAttrs
+	if cattrs.Rate, err = parseRate(command.OptionRate); err != nil {
+		return nil, err
+	}
+	if command.OptionCeil != "" {
+		if cattrs.Ceil, err = parseRate(command.OptionCeil); err != nil {
+			return nil, err
+		}
+	}
+	return netlink.NewHtbClass(attrs, cattrs), nil
+}
+
+// getTcLink finds 'dev' of qdisc/class in the target namespace
+func getTcLink(command *parser.Command) (netlink.Link, error) {
+	if command.OptionDev == "" {
+		return nil, fmt.Errorf("qdisc/class requires dev")
+	}
+	link, err := netlink.LinkByName(command.OptionDev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find link %q: %v", command.OptionDev, err)
+	}
+	return link, nil
+}
+
+// AddDelQdisc adds/replaces/deletes qdisc or htb class with netlink API
+func AddDelQdisc(command *parser.Command) (err error) {
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		link, err1 := getTcLink(command)
+		if err1 != nil {
+			return err1
+		}
+		var qdisc netlink.Qdisc
+		var class netlink.Class
+		switch command.Operation {
+		case parser.QDISCADD, parser.QDISCREPLACE, parser.QDISCDEL:
+			qdisc, err1 = GetNetlinkQdisc(command, link.Attrs().Index)
+		default:
+			class, err1 = GetNetlinkClass(command, link.Attrs().Index)
+		}
+		if err1 != nil {
+			return err1
+		}
+
+		switch command.Operation {
+		case parser.QDISCADD:
+			err1 = netlink.QdiscAdd(qdisc)
+		case parser.QDISCREPLACE:
+			err1 = netlink.QdiscReplace(qdisc)
+		case parser.QDISCDEL:
+			err1 = netlink.QdiscDel(qdisc)
+		case parser.CLASSADD:
+			err1 = netlink.ClassAdd(class)
+		case parser.CLASSREPLACE:
+			err1 = netlink.ClassReplace(class)
+		case parser.CLASSDEL:
+			err1 = netlink.ClassDel(class)
+		}
+		if err1 == nil {
+			return nil
+		}
+		switch command.Operation {
+		case parser.QDISCADD, parser.CLASSADD:
+			if isExisting(command, err1) {
+				return errUnchanged
+			}
+		case parser.QDISCDEL, parser.CLASSDEL:
+			if isMissing(command, err1) {
+				return errUnchanged
+			}
+		}
+		return fmt.Errorf("failed on %q: %v", command.OptionDev, err1)
+	})
+}
+
+// formatQdisc formats qdisc as 'tc qdisc show' does
+func formatQdisc(qdisc netlink.Qdisc, name string) string {
+	attrs := qdisc.Attrs()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "qdisc %s %s dev %s", qdisc.Type(), formatTcHandle(attrs.Handle), name)
+	if attrs.Parent == netlink.HANDLE_ROOT {
+		b.WriteString(" root")
+	} else {
+		fmt.Fprintf(&b, " parent %s", formatTcHandle(attrs.Parent))
+	}
+	switch q := qdisc.(type) {
+	case *netlink.Netem:
+		if q.Latency != 0 {
+			fmt.Fprintf(&b, " delay %s", time.Duration(q.Latency)*time.Microsecond)
+			if q.Jitter != 0 {
+				fmt.Fprintf(&b, " jitter %s", time.Duration(q.Jitter)*time.Microsecond)
+			}
+		}
+		if q.Loss != 0 {
+			fmt.Fprintf(&b, " loss %g%%", float64(q.Loss)/math.MaxUint32*100)
+		}
+		if q.Duplicate != 0 {
+			fmt.Fprintf(&b, " duplicate %g%%", float64(q.Duplicate)/math.MaxUint32*100)
+		}
+	case *netlink.Tbf:
+		fmt.Fprintf(&b, " rate %s limit %db", formatRate(q.Rate*8), q.Limit)
+	case *netlink.Htb:
+		fmt.Fprintf(&b, " default %#x", q.Defcls)
+	}
+	return b.String()
+}
+
+// formatClass formats class as 'tc class show' does
+func formatClass(class netlink.Class) string {
+	attrs := class.Attrs()
+	s := fmt.Sprintf("class %s %s parent %s", class.Type(),
+		formatTcHandle(attrs.Handle), formatTcHandle(attrs.Parent))
+	if htb, ok := class.(*netlink.HtbClass); ok {
+		s += fmt.Sprintf(" rate %s ceil %s", formatRate(htb.Rate*8), formatRate(htb.Ceil*8))
+	}
+	return s
+}
+
+// ShowQdisc shows qdiscs or classes of the link, or qdiscs of all links if
+// dev is not given
+func ShowQdisc(command *parser.Command) (err error) {
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		var links []netlink.Link
+		var err1 error
+		if command.OptionDev != "" || command.Operation == parser.CLASSSHOW {
+			link, err1 := getTcLink(command)
+			if err1 != nil {
+				return err1
+			}
+			links = []netlink.Link{link}
+		} else if links, err1 = netlink.LinkList(); err1 != nil {
+			return err1
+		}
+
+		for _, link := range links {
+			if command.Operation == parser.CLASSSHOW {
+				classes, err1 := netlink.ClassList(link, netlink.HANDLE_NONE)
+				if err1 != nil {
+					return err1
+				}
+				for _, class := range classes {
+					fmt.Println(formatClass(class))
+				}
+				continue
+			}
+			qdiscs, err1 := netlink.QdiscList(link)
+			if err1 != nil {
+				return err1
+			}
+			for _, qdisc := range qdiscs {
+				fmt.Println(formatQdisc(qdisc, link.Attrs().Name))
+			}
+		}
+		return nil
+	})
+}
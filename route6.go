@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// routePrefs are router preferences of IPv6 route (RFC 4191), as
+// ICMPV6_ROUTER_PREF_* of the kernel
+var routePrefs = map[string]uint8{
+	"low":    3,
+	"medium": 0,
+	"high":   1,
+}
+
+// ipv6RouteOptions are pref/expires of IPv6 route, which netlink.Route does
+// not support
+type ipv6RouteOptions struct {
+	pref    *uint8
+	expires uint32
+}
+
+// isIPv6Route checks whether the route is in IPv6 family
+func isIPv6Route(route *netlink.Route) bool {
+	if route.MPLSDst != nil {
+		return false
+	}
+	if route.Dst != nil {
+		return route.Dst.IP.To4() == nil
+	}
+	return route.Gw != nil && route.Gw.To4() == nil
+}
+
+// GetIPv6RouteOptions converts pref/expires of route given in CLI. It returns
+// nil if neither is given.
+func GetIPv6RouteOptions(command *parser.Command, route *netlink.Route) (*ipv6RouteOptions, error) {
+	if command.OptionPref == "" && command.OptionExpires == "" {
+		return nil, nil
+	}
+	if !isIPv6Route(route) {
+		return nil, fmt.Errorf("pref and expires are only for IPv6 route")
+	}
+	options := &ipv6RouteOptions{}
+	if command.OptionPref != "" {
+		pref, ok := routePrefs[command.OptionPref]
+		if !ok {
+			return nil, fmt.Errorf("invalid pref %q", command.OptionPref)
+		}
+		options.pref = &pref
+	}
+	if command.OptionExpires != "" {
+		val, err := strconv.ParseUint(command.OptionExpires, 10, 32)
+		if err != nil || val == 0 {
+			return nil, fmt.Errorf("invalid expires %q", command.OptionExpires)
+		}
+		options.expires = uint32(val)
+	}
+	return options, nil
+}
+
+// modify sends RTM_NEWROUTE of the route with pref/expires. flags are
+// NLM_F_* of add/replace/change.
+func (o *ipv6RouteOptions) modify(route *netlink.Route, flags int) error {
+	req := nl.NewNetlinkRequest(unix.RTM_NEWROUTE, flags|unix.NLM_F_ACK)
+	msg := nl.NewRtMsg()
+	msg.Family = unix.AF_INET6
+	msg.Scope = uint8(route.Scope)
+	msg.Flags = uint32(route.Flags)
+	if route.Protocol > 0 {
+		msg.Protocol = uint8(route.Protocol)
+	}
+	var attrs []*nl.RtAttr
+	if route.Dst != nil {
+		ones, _ := route.Dst.Mask.Size()
+		msg.Dst_len = uint8(ones)
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_DST, route.Dst.IP.To16()))
+	}
+	if route.Table > 0 {
+		msg.Table = unix.RT_TABLE_UNSPEC
+		if route.Table < 256 {
+			msg.Table = uint8(route.Table)
+		}
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_TABLE, nl.Uint32Attr(uint32(route.Table))))
+	}
+	if route.Gw != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_GATEWAY, route.Gw.To16()))
+	}
+	if route.LinkIndex > 0 {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_OIF, nl.Uint32Attr(uint32(route.LinkIndex))))
+	}
+	if route.Encap != nil {
+		buf, err := route.Encap.Encode()
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_ENCAP_TYPE, nl.Uint16Attr(uint16(route.Encap.Type()))))
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_ENCAP, buf))
+	}
+	if o.pref != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_PREF, []byte{*o.pref}))
+	}
+	if o.expires > 0 {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_EXPIRES, nl.Uint32Attr(o.expires)))
+	}
+
+	req.AddData(msg)
+	for _, attr := range attrs {
+		req.AddData(attr)
+	}
+	_, err := req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-nfvpe/koro/parser"
+)
+
+// builtinTables are table names which iproute2 knows without rt_tables
+var builtinTables = map[string]int{
+	"unspec":  0,
+	"default": 253,
+	"main":    254,
+	"local":   255,
+}
+
+var procNSPath = regexp.MustCompile(`^/proc/([0-9]+)/ns/net$`)
+
+// getEtcDir returns the directory which is seen as /etc from the target of
+// the command, to read its configuration files.
+func getEtcDir(command *parser.Command) (string, error) {
+	switch command.TargetType {
+	case parser.NSNONE:
+		return "/etc", nil
+	case parser.IPNETNS:
+		// 'ip netns exec' bind-mounts /etc/netns/NAME/* over /etc
+		return filepath.Join("/etc/netns", command.Target), nil
+	}
+	nsName, err := getNamepace(command)
+	if err != nil {
+		return "", err
+	}
+	if m := procNSPath.FindStringSubmatch(nsName); m != nil {
+		return fmt.Sprintf("/proc/%s/root/etc", m[1]), nil
+	}
+	return "/etc", nil
+}
+
+// parseRtTables reads table definitions in rt_tables format into tables
+func parseRtTables(path string, tables map[string]int) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		id, err := strconv.ParseUint(fields[0], 0, 32)
+		if err != nil {
+			continue
+		}
+		tables[fields[1]] = int(id)
+	}
+	return scanner.Err()
+}
+
+// readRtTables reads rt_tables and rt_tables.d/*.conf under etcDir. Either
+// of them may be missing, and it returns the error of rt_tables only if both
+// are missing.
+func readRtTables(etcDir string) (tables map[string]int, err error) {
+	tables = map[string]int{}
+	err = parseRtTables(filepath.Join(etcDir, "iproute2/rt_tables"), tables)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	files, err1 := ioutil.ReadDir(filepath.Join(etcDir, "iproute2/rt_tables.d"))
+	if err != nil && os.IsNotExist(err1) {
+		return nil, err
+	}
+	names := []string{}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".conf") {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err = parseRtTables(filepath.Join(etcDir, "iproute2/rt_tables.d", name), tables); err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+// lookupTableName resolves table name from rt_tables of the target. Host
+// rt_tables is used if the target does not have its own.
+func lookupTableName(command *parser.Command, table string) (id int, err error) {
+	etcDir, err := getEtcDir(command)
+	if err != nil {
+		return 0, err
+	}
+	etcDirs := []string{etcDir}
+	if etcDir != "/etc" {
+		etcDirs = append(etcDirs, "/etc")
+	}
+
+	for _, etcDir := range etcDirs {
+		tables, err := readRtTables(etcDir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if id, ok := tables[table]; ok {
+			return id, nil
+		}
+		break
+	}
+	if id, ok := builtinTables[table]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("table %q is not found in rt_tables of %s", table, etcDir)
+}
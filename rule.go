@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+)
+
+// parseRulePrefix parses 'from'/'to' selector of rule. "all" matches any
+// address, so it returns nil.
+func parseRulePrefix(prefix string) (*net.IPNet, error) {
+	if prefix == "all" {
+		return nil, nil
+	}
+	if !strings.Contains(prefix, "/") {
+		ip := net.ParseIP(prefix)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid prefix %q", prefix)
+		}
+		if ip.To4() != nil {
+			return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipnet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix %q", prefix)
+	}
+	return ipnet, nil
+}
+
+// parseFwmark parses 'fwmark' selector of rule, given as MARK[/MASK]
+func parseFwmark(fwmark string) (mark uint32, mask *uint32, err error) {
+	markStr, maskStr := fwmark, ""
+	if i := strings.Index(fwmark, "/"); i >= 0 {
+		markStr, maskStr = fwmark[:i], fwmark[i+1:]
+	}
+	val, err := strconv.ParseUint(markStr, 0, 32)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid fwmark %q", fwmark)
+	}
+	mark = uint32(val)
+	if maskStr != "" {
+		val, err = strconv.ParseUint(maskStr, 0, 32)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid fwmark mask %q", fwmark)
+		}
+		m := uint32(val)
+		mask = &m
+	}
+	return mark, mask, nil
+}
+
+// ipFamily returns netlink address family of given ip
+func ipFamily(ip net.IP) int {
+	if ip.To4() != nil {
+		return netlink.FAMILY_V4
+	}
+	return netlink.FAMILY_V6
+}
+
+// ruleFamilies are address families of rule given in CLI
+var ruleFamilies = map[string]int{
+	"inet":  netlink.FAMILY_V4,
+	"inet6": netlink.FAMILY_V6,
+}
+
+// GetNetlinkRule converts from CLI argument to netlink.Rule structure
+func GetNetlinkRule(command *parser.Command) (rule *netlink.Rule, err error) {
+	rule = netlink.NewRule()
+	rule.Invert = command.IsNot
+	rule.IifName = command.OptionIif
+	rule.OifName = command.OptionOif
+
+	if command.OptionFrom != "" {
+		if rule.Src, err = parseRulePrefix(command.OptionFrom); err != nil {
+			return nil, err
+		}
+	}
+	if command.OptionTo != "" {
+		if rule.Dst, err = parseRulePrefix(command.OptionTo); err != nil {
+			return nil, err
+		}
+	}
+	if command.OptionFwmark != "" {
+		if rule.Mark, rule.Mask, err = parseFwmark(command.OptionFwmark); err != nil {
+			return nil, err
+		}
+	}
+	if command.OptionTable != "" {
+		if rule.Table, err = getTableID(command, command.OptionTable); err != nil {
+			return nil, err
+		}
+	} else if command.Operation == parser.RULEADD {
+		return nil, fmt.Errorf("rule add requires table")
+	}
+	if command.OptionPriority != "" {
+		if rule.Priority, err = strconv.Atoi(command.OptionPriority); err != nil || rule.Priority < 0 {
+			return nil, fmt.Errorf("invalid priority %q", command.OptionPriority)
+		}
+	}
+
+	rule.Family = netlink.FAMILY_V4
+	if command.OptionFamily != "" {
+		rule.Family = ruleFamilies[command.OptionFamily]
+	}
+	if rule.Src != nil && rule.Dst != nil && ipFamily(rule.Src.IP) != ipFamily(rule.Dst.IP) {
+		return nil, fmt.Errorf("address family mismatch between from and to")
+	}
+	for _, prefix := range []*net.IPNet{rule.Src, rule.Dst} {
+		if prefix == nil {
+			continue
+		}
+		if command.OptionFamily != "" && ipFamily(prefix.IP) != rule.Family {
+			return nil, fmt.Errorf("address family mismatch between %s and %s",
+				command.OptionFamily, prefix)
+		}
+		rule.Family = ipFamily(prefix.IP)
+	}
+	return rule, nil
+}
+
+// formatRule formats rule as 'ip rule show' does
+func formatRule(rule netlink.Rule) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d:\t", rule.Priority)
+	if rule.Invert {
+		b.WriteString("not ")
+	}
+	if rule.Src != nil {
+		fmt.Fprintf(&b, "from %s", rule.Src)
+	} else {
+		b.WriteString("from all")
+	}
+	if rule.Dst != nil {
+		fmt.Fprintf(&b, " to %s", rule.Dst)
+	}
+	if rule.Mark != 0 || rule.Mask != nil {
+		fmt.Fprintf(&b, " fwmark %#x", rule.Mark)
+		if rule.Mask != nil {
+			fmt.Fprintf(&b, "/%#x", *rule.Mask)
+		}
+	}
+	if rule.IifName != "" {
+		fmt.Fprintf(&b, " iif %s", rule.IifName)
+	}
+	if rule.OifName != "" {
+		fmt.Fprintf(&b, " oif %s", rule.OifName)
+	}
+	fmt.Fprintf(&b, " lookup %d", rule.Table)
+	return b.String()
+}
+
+// AddDelRule adds/deletes policy routing rule with netlink API
+func AddDelRule(command *parser.Command) (err error) {
+	rule, err := GetNetlinkRule(command)
+	if err != nil {
+		return err
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		switch command.Operation {
+		case parser.RULEADD:
+			if err1 := netlink.RuleAdd(rule); err1 != nil {
+				if isExisting(command, err1) {
+					return errUnchanged
+				}
+				return fmt.Errorf("failed to add rule %v: %v", rule, err1)
+			}
+		case parser.RULEDEL:
+			if err1 := netlink.RuleDel(rule); err1 != nil {
+				if isMissing(command, err1) {
+					return errUnchanged
+				}
+				return fmt.Errorf("failed to delete rule %v: %v", rule, err1)
+			}
+		}
+		return nil
+	})
+}
+
+// ShowRule shows policy routing rules in the namespace
+func ShowRule(command *parser.Command) (err error) {
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		families := []int{netlink.FAMILY_V4, netlink.FAMILY_V6}
+		if command.OptionFamily != "" {
+			families = []int{ruleFamilies[command.OptionFamily]}
+		}
+		for _, family := range families {
+			rules, err1 := netlink.RuleList(family)
+			if err1 != nil {
+				return err1
+			}
+			for _, rule := range rules {
+				fmt.Println(formatRule(rule))
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+)
+
+// sysctlPath converts sysctl key into the path under /proc/sys. Only net.*
+// keys are allowed because others are not per network namespace. '/' in the
+// key is for '.' in the component, such as net.ipv4.conf.eth0/100.rp_filter.
+func sysctlPath(key string) (string, error) {
+	components := strings.Split(key, ".")
+	if len(components) < 2 || components[0] != "net" {
+		return "", fmt.Errorf("sysctl %q is not net.*", key)
+	}
+	for i, component := range components {
+		component = strings.Replace(component, "/", ".", -1)
+		if component == "" || component == "." || component == ".." {
+			return "", fmt.Errorf("invalid sysctl %q", key)
+		}
+		components[i] = component
+	}
+	return filepath.Join(append([]string{"/proc/sys"}, components...)...), nil
+}
+
+// readSysctl reads the value of sysctl in the current namespace
+func readSysctl(path string) (string, error) {
+	val, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(strings.Fields(string(val)), " "), nil
+}
+
+// GetSetSysctl shows or sets net.* sysctl in the namespace. set prints the
+// old value to roll back.
+func GetSetSysctl(command *parser.Command) (err error) {
+	path, err := sysctlPath(command.OptionKey)
+	if err != nil {
+		return err
+	}
+
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		old, err1 := readSysctl(path)
+		if err1 != nil {
+			return fmt.Errorf("failed to read %s: %v", command.OptionKey, err1)
+		}
+		if command.Operation == parser.SYSCTLGET {
+			fmt.Printf("%s = %s\n", command.OptionKey, old)
+			return nil
+		}
+		value := strings.Join(strings.Fields(command.OptionValue), " ")
+		if old == value {
+			fmt.Printf("%s = %s\n", command.OptionKey, old)
+			return errUnchanged
+		}
+		if err1 = ioutil.WriteFile(path, []byte(value), 0644); err1 != nil {
+			return fmt.Errorf("failed to write %s: %v", command.OptionKey, err1)
+		}
+		fmt.Printf("%s = %s (was %s)\n", command.OptionKey, value, old)
+		return nil
+	})
+}
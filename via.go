@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+)
+
+// viaTargetTypes are namespace types of 'via TYPE:NAME[@DEV]'
+var viaTargetTypes = map[string]int{
+	"docker":  parser.DOCKER,
+	"ipnetns": parser.IPNETNS,
+	"netns":   parser.NETNS,
+	"pid":     parser.PID,
+}
+
+// defaultViaDev is the link of the peer namespace when DEV is omitted
+const defaultViaDev = "eth0"
+
+// parseViaTarget parses via given as TYPE:NAME[@DEV] into the command which
+// targets the peer namespace, and the link in it. It returns nil if via is
+// not in the form.
+func parseViaTarget(via string) (peer *parser.Command, dev string) {
+	i := strings.Index(via, ":")
+	if i < 0 {
+		return nil, ""
+	}
+	targetType, ok := viaTargetTypes[via[:i]]
+	if !ok {
+		return nil, ""
+	}
+	target, dev := via[i+1:], defaultViaDev
+	if j := strings.LastIndex(target, "@"); j >= 0 {
+		target, dev = target[:j], target[j+1:]
+	}
+	return &parser.Command{TargetType: targetType, Target: target}, dev
+}
+
+// getPeerAddress returns the global address of the family on the link in the
+// peer namespace
+func getPeerAddress(peer *parser.Command, dev string, family int) (ip net.IP, err error) {
+	if peer.Target == "" || dev == "" {
+		return nil, fmt.Errorf("via requires TYPE:NAME[@DEV]")
+	}
+	peerNS, err := getTargetNS(peer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", targetString(peer), err)
+	}
+	defer peerNS.Close()
+
+	err = peerNS.Do(func(_ ns.NetNS) error {
+		link, err1 := netlink.LinkByName(dev)
+		if err1 != nil {
+			return fmt.Errorf("failed to find link %q in %s: %v", dev, targetString(peer), err1)
+		}
+		addrs, err1 := netlink.AddrList(link, family)
+		if err1 != nil {
+			return err1
+		}
+		for _, addr := range addrs {
+			if addr.Scope == int(netlink.SCOPE_UNIVERSE) {
+				ip = addr.IP
+				return nil
+			}
+		}
+		familyName := "IPv4"
+		if family == netlink.FAMILY_V6 {
+			familyName = "IPv6"
+		}
+		return fmt.Errorf("no global %s address on %s in %s", familyName, dev, targetString(peer))
+	})
+	return ip, err
+}
+
+// getViaFamily returns the address family of the gateway to take from the
+// peer namespace. It is IPv6 with 'via inet6' (IPv4 route via IPv6 nexthop,
+// even for default route) or IPv6 dst, and IPv4 otherwise.
+func getViaFamily(command *parser.Command, dst *net.IPNet) int {
+	if command.IsViaInet6 || (dst != nil && ipFamily(dst.IP) == netlink.FAMILY_V6) {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
+
+// getViaAddress converts via given in CLI into the gateway address. via is
+// the address, or the address of the peer namespace as TYPE:NAME[@DEV] in
+// the family.
+func getViaAddress(command *parser.Command, family int) (net.IP, error) {
+	if peer, dev := parseViaTarget(command.OptionVia); peer != nil {
+		return getPeerAddress(peer, dev, family)
+	}
+	ip := net.ParseIP(command.OptionVia)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid via %q", command.OptionVia)
+	}
+	return ip, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/redhat-nfvpe/koro/parser"
+	"github.com/vishvananda/netlink"
+)
+
+// getVrfTable returns the routing table of the VRF device. It needs to be
+// called in the target namespace.
+func getVrfTable(name string) (int, error) {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find vrf %q: %v", name, err)
+	}
+	vrf, ok := link.(*netlink.Vrf)
+	if !ok {
+		return 0, fmt.Errorf("%q is not vrf", name)
+	}
+	return int(vrf.Table), nil
+}
+
+// ShowVrf shows VRFs in the namespace with their members and routes
+func ShowVrf(command *parser.Command) (err error) {
+	targetNS, err := getTargetNS(command)
+	if err != nil {
+		return err
+	}
+	defer targetNS.Close()
+
+	return targetNS.Do(func(_ ns.NetNS) error {
+		links, err1 := netlink.LinkList()
+		if err1 != nil {
+			return err1
+		}
+		for _, link := range links {
+			vrf, ok := link.(*netlink.Vrf)
+			if !ok {
+				continue
+			}
+			fmt.Printf("%s table %d\n", vrf.Name, vrf.Table)
+			for _, member := range links {
+				if member.Attrs().MasterIndex == vrf.Index {
+					fmt.Printf("    member %s\n", member.Attrs().Name)
+				}
+			}
+			routes, err1 := listRoutes(int(vrf.Table), 0)
+			if err1 != nil {
+				return err1
+			}
+			for _, route := range routes {
+				fmt.Printf("    route %s\n", formatRoute(route))
+			}
+		}
+		return nil
+	})
+}